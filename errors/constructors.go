@@ -0,0 +1,260 @@
+package errors
+
+import (
+	"fmt"
+	"time"
+)
+
+// baseError is the common base of errors created by the constructors in this package.
+// It formats its message using fmt.Errorf semantics, so causes can be wrapped using %w.
+type baseError struct {
+	err error
+}
+
+func newBaseError(format string, args ...interface{}) baseError {
+	return baseError{err: fmt.Errorf(format, args...)}
+}
+
+func (e baseError) Error() string {
+	return e.err.Error()
+}
+
+func (e baseError) Unwrap() error {
+	return e.err
+}
+
+// ServiceError marks the error to be returned to the client for processing.
+func (baseError) ServiceError() bool {
+	return true
+}
+
+type notFoundError struct {
+	baseError
+}
+
+func (notFoundError) NotFound() bool {
+	return true
+}
+
+// NewNotFound returns a new NotFound service error for a resource identified by id.
+func NewNotFound(resource string, id interface{}) error {
+	return notFoundError{newBaseError("%s %v not found", resource, id)}
+}
+
+// NewNotFoundf returns a new NotFound service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewNotFoundf(format string, args ...interface{}) error {
+	return notFoundError{newBaseError(format, args...)}
+}
+
+type validationError struct {
+	baseError
+
+	violations map[string][]string
+}
+
+func (validationError) Validation() bool {
+	return true
+}
+
+func (e validationError) Violations() map[string][]string {
+	return e.violations
+}
+
+// NewValidation returns a new Validation service error with a list of violations for each field.
+func NewValidation(msg string, violations map[string][]string) error {
+	return validationError{
+		baseError:  newBaseError("%s", msg),
+		violations: violations,
+	}
+}
+
+// NewValidationf returns a new Validation service error with a list of violations for each field.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewValidationf(violations map[string][]string, format string, args ...interface{}) error {
+	return validationError{
+		baseError:  newBaseError(format, args...),
+		violations: violations,
+	}
+}
+
+type fieldValidationError struct {
+	baseError
+
+	violations []FieldViolation
+}
+
+func (fieldValidationError) Validation() bool {
+	return true
+}
+
+func (e fieldValidationError) FieldViolations() []FieldViolation {
+	return e.violations
+}
+
+func (e fieldValidationError) Violations() map[string][]string {
+	return FieldViolationsToMap(e.violations)
+}
+
+// NewFieldValidation returns a new Validation service error with an ordered list of field violations.
+func NewFieldValidation(msg string, violations []FieldViolation) error {
+	return fieldValidationError{
+		baseError:  newBaseError("%s", msg),
+		violations: violations,
+	}
+}
+
+type badRequestError struct {
+	baseError
+}
+
+func (badRequestError) BadRequest() bool {
+	return true
+}
+
+// NewBadRequest returns a new BadRequest service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewBadRequest(format string, args ...interface{}) error {
+	return badRequestError{newBaseError(format, args...)}
+}
+
+type conflictError struct {
+	baseError
+}
+
+func (conflictError) Conflict() bool {
+	return true
+}
+
+// NewConflict returns a new Conflict service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewConflict(format string, args ...interface{}) error {
+	return conflictError{newBaseError(format, args...)}
+}
+
+type unauthenticatedError struct {
+	baseError
+}
+
+func (unauthenticatedError) Unauthenticated() bool {
+	return true
+}
+
+// NewUnauthenticated returns a new Unauthenticated service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewUnauthenticated(format string, args ...interface{}) error {
+	return unauthenticatedError{newBaseError(format, args...)}
+}
+
+type permissionDeniedError struct {
+	baseError
+}
+
+func (permissionDeniedError) PermissionDenied() bool {
+	return true
+}
+
+// NewPermissionDenied returns a new PermissionDenied service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewPermissionDenied(format string, args ...interface{}) error {
+	return permissionDeniedError{newBaseError(format, args...)}
+}
+
+type tooManyRequestsError struct {
+	baseError
+
+	retryAfter time.Duration
+}
+
+func (tooManyRequestsError) TooManyRequests() bool {
+	return true
+}
+
+func (e tooManyRequestsError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// NewTooManyRequests returns a new TooManyRequests service error
+// telling the client to retry after the specified duration (if positive).
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewTooManyRequests(retryAfter time.Duration, format string, args ...interface{}) error {
+	return tooManyRequestsError{
+		baseError:  newBaseError(format, args...),
+		retryAfter: retryAfter,
+	}
+}
+
+type unavailableError struct {
+	baseError
+}
+
+func (unavailableError) Unavailable() bool {
+	return true
+}
+
+// NewUnavailable returns a new Unavailable service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewUnavailable(format string, args ...interface{}) error {
+	return unavailableError{newBaseError(format, args...)}
+}
+
+type timeoutError struct {
+	baseError
+}
+
+func (timeoutError) TimeoutError() bool {
+	return true
+}
+
+// NewTimeout returns a new Timeout service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewTimeout(format string, args ...interface{}) error {
+	return timeoutError{newBaseError(format, args...)}
+}
+
+type preconditionFailedError struct {
+	baseError
+}
+
+func (preconditionFailedError) PreconditionFailed() bool {
+	return true
+}
+
+// NewPreconditionFailed returns a new PreconditionFailed service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewPreconditionFailed(format string, args ...interface{}) error {
+	return preconditionFailedError{newBaseError(format, args...)}
+}
+
+type alreadyExistsError struct {
+	baseError
+}
+
+func (alreadyExistsError) AlreadyExists() bool {
+	return true
+}
+
+// NewAlreadyExists returns a new AlreadyExists service error for a resource identified by id.
+func NewAlreadyExists(resource string, id interface{}) error {
+	return alreadyExistsError{newBaseError("%s %v already exists", resource, id)}
+}
+
+// NewAlreadyExistsf returns a new AlreadyExists service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewAlreadyExistsf(format string, args ...interface{}) error {
+	return alreadyExistsError{newBaseError(format, args...)}
+}
+
+type notImplementedError struct {
+	baseError
+}
+
+func (notImplementedError) NotImplemented() bool {
+	return true
+}
+
+// NewNotImplemented returns a new NotImplemented service error.
+// The message is formatted according to fmt.Errorf, so causes can be wrapped using %w.
+func NewNotImplemented(format string, args ...interface{}) error {
+	return notImplementedError{newBaseError(format, args...)}
+}
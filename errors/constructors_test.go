@@ -0,0 +1,228 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestConstructors(t *testing.T) {
+	cause := errors.New("cause")
+
+	tests := []struct {
+		name    string
+		err     error
+		matcher func(err error) bool
+		message string
+	}{
+		{
+			name:    "NotFound",
+			err:     NewNotFound("user", 1),
+			matcher: IsNotFoundError,
+			message: "user 1 not found",
+		},
+		{
+			name:    "NotFoundf",
+			err:     NewNotFoundf("user not found: %w", cause),
+			matcher: IsNotFoundError,
+			message: "user not found: cause",
+		},
+		{
+			name:    "Validation",
+			err:     NewValidation("invalid user", nil),
+			matcher: IsValidationError,
+			message: "invalid user",
+		},
+		{
+			name:    "Validationf",
+			err:     NewValidationf(nil, "invalid user: %w", cause),
+			matcher: IsValidationError,
+			message: "invalid user: cause",
+		},
+		{
+			name:    "BadRequest",
+			err:     NewBadRequest("bad request: %w", cause),
+			matcher: IsBadRequestError,
+			message: "bad request: cause",
+		},
+		{
+			name:    "Conflict",
+			err:     NewConflict("conflict: %w", cause),
+			matcher: IsConflictError,
+			message: "conflict: cause",
+		},
+		{
+			name:    "Unauthenticated",
+			err:     NewUnauthenticated("unauthenticated: %w", cause),
+			matcher: IsUnauthenticatedError,
+			message: "unauthenticated: cause",
+		},
+		{
+			name:    "PermissionDenied",
+			err:     NewPermissionDenied("permission denied: %w", cause),
+			matcher: IsPermissionDeniedError,
+			message: "permission denied: cause",
+		},
+		{
+			name:    "TooManyRequests",
+			err:     NewTooManyRequests(time.Minute, "too many requests: %w", cause),
+			matcher: IsTooManyRequestsError,
+			message: "too many requests: cause",
+		},
+		{
+			name:    "Unavailable",
+			err:     NewUnavailable("unavailable: %w", cause),
+			matcher: IsUnavailableError,
+			message: "unavailable: cause",
+		},
+		{
+			name:    "Timeout",
+			err:     NewTimeout("timeout: %w", cause),
+			matcher: IsTimeoutError,
+			message: "timeout: cause",
+		},
+		{
+			name:    "PreconditionFailed",
+			err:     NewPreconditionFailed("precondition failed: %w", cause),
+			matcher: IsPreconditionFailedError,
+			message: "precondition failed: cause",
+		},
+		{
+			name:    "AlreadyExists",
+			err:     NewAlreadyExists("user", 1),
+			matcher: IsAlreadyExistsError,
+			message: "user 1 already exists",
+		},
+		{
+			name:    "AlreadyExistsf",
+			err:     NewAlreadyExistsf("user already exists: %w", cause),
+			matcher: IsAlreadyExistsError,
+			message: "user already exists: cause",
+		},
+		{
+			name:    "NotImplemented",
+			err:     NewNotImplemented("not implemented: %w", cause),
+			matcher: IsNotImplementedError,
+			message: "not implemented: cause",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", test.err)
+
+			if !test.matcher(err) {
+				t.Errorf("error is supposed to be a %s error", test.name)
+			}
+
+			if !IsServiceError(err) {
+				t.Error("error is supposed to be a ServiceError")
+			}
+
+			if want, have := test.message, test.err.Error(); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestConstructors_Cause(t *testing.T) {
+	cause := errors.New("cause")
+
+	errs := []error{
+		NewConflict("conflict: %w", cause),
+		NewNotFoundf("user not found: %w", cause),
+		NewValidationf(nil, "invalid user: %w", cause),
+		NewAlreadyExistsf("user already exists: %w", cause),
+	}
+
+	for _, err := range errs {
+		if !errors.Is(err, cause) {
+			t.Errorf("error is supposed to wrap the cause: %s", err)
+		}
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	err := NewValidation("invalid user", map[string][]string{
+		"email": {"required"},
+	})
+
+	var verr interface {
+		Violations() map[string][]string
+	}
+
+	if !errors.As(err, &verr) {
+		t.Fatal("error is supposed to carry violations")
+	}
+
+	if want, have := "required", verr.Violations()["email"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewValidationf(t *testing.T) {
+	err := NewValidationf(map[string][]string{"email": {"required"}}, "invalid user: %w", errors.New("cause"))
+
+	violations, ok := Violations(err)
+	if !ok {
+		t.Fatal("error is supposed to carry violations")
+	}
+
+	if want, have := "required", violations["email"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewFieldValidation(t *testing.T) {
+	err := NewFieldValidation("invalid user", []FieldViolation{
+		{Path: []string{"name"}, Code: "required", Message: "name is required"},
+		{Path: []string{"addresses", "0", "city"}, Code: "required", Message: "city is required"},
+	})
+
+	if !IsValidationError(err) {
+		t.Error("error is supposed to be a Validation error")
+	}
+
+	fieldViolations, ok := FieldViolations(err)
+	if !ok {
+		t.Fatal("error is supposed to carry field violations")
+	}
+
+	if want, have := "addresses.0.city", fieldViolations[1].Field(); want != have {
+		t.Errorf("unexpected field\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	violations, ok := Violations(err)
+	if !ok {
+		t.Fatal("error is supposed to carry violations")
+	}
+
+	if want, have := "city is required", violations["addresses.0.city"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewTooManyRequests(t *testing.T) {
+	err := NewTooManyRequests(time.Minute, "too many requests")
+
+	retryAfter, ok := RetryAfter(err)
+	if !ok {
+		t.Fatal("error is supposed to carry retry information")
+	}
+
+	if want, have := time.Minute, retryAfter; want != have {
+		t.Errorf("unexpected duration\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func ExampleNewNotFound() {
+	err := NewNotFound("user", 1)
+
+	fmt.Println(err, IsNotFoundError(err), IsServiceError(err))
+
+	// Output: user 1 not found true true
+}
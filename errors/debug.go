@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Chain returns every error in the Unwrap chain of an error (including the error itself) in depth-first order.
+// Both Unwrap() error and Unwrap() []error (eg. errors returned by errors.Join) are followed.
+func Chain(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	chain := []error{err}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		chain = append(chain, Chain(e.Unwrap())...)
+
+	case interface{ Unwrap() []error }:
+		for _, cause := range e.Unwrap() {
+			chain = append(chain, Chain(cause)...)
+		}
+	}
+
+	return chain
+}
+
+// StackTrace returns the stack trace of the first error in the Unwrap chain (see Chain) that exposes one.
+// An error exposes a stack trace if it implements the following interface:
+//
+//	type stackTracer interface {
+//		StackTrace() T
+//	}
+//
+// where T is either []string or any type formatted with "%+v" into a multiline stack trace
+// (eg. the stack trace of github.com/pkg/errors).
+func StackTrace(err error) ([]string, bool) {
+	for _, e := range Chain(err) {
+		if stackTrace := stackTraceOf(e); len(stackTrace) > 0 {
+			return stackTrace, true
+		}
+	}
+
+	return nil, false
+}
+
+func stackTraceOf(err error) []string {
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil
+	}
+
+	trace := method.Call(nil)[0].Interface()
+
+	if entries, ok := trace.([]string); ok {
+		return entries
+	}
+
+	var entries []string
+
+	for _, line := range strings.Split(fmt.Sprintf("%+v", trace), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			entries = append(entries, line)
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,92 @@
+package errors
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	joined := errors.Join(sql.ErrNoRows, sql.ErrTxDone)
+	wrapped := fmt.Errorf("wrapped: %w", joined)
+
+	expected := []error{wrapped, joined, sql.ErrNoRows, sql.ErrTxDone}
+
+	if want, have := expected, Chain(wrapped); !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected chain\nexpected: %v\nactual:   %v", want, have)
+	}
+
+	if Chain(nil) != nil {
+		t.Error("the chain of a nil error is supposed to be empty")
+	}
+}
+
+type stackTraceStub struct{}
+
+func (stackTraceStub) Error() string {
+	return "error"
+}
+
+func (stackTraceStub) StackTrace() []string {
+	return []string{"main.main", "runtime.main"}
+}
+
+type formattedStackTrace []string
+
+func (s formattedStackTrace) Format(f fmt.State, _ rune) {
+	for _, frame := range s {
+		fmt.Fprintf(f, "\n%s\n\t%s.go:1", frame, frame)
+	}
+}
+
+type formattedStackTraceStub struct{}
+
+func (formattedStackTraceStub) Error() string {
+	return "error"
+}
+
+func (formattedStackTraceStub) StackTrace() formattedStackTrace {
+	return formattedStackTrace{"main"}
+}
+
+func TestStackTrace(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name:     "Strings",
+			err:      fmt.Errorf("wrapped: %w", stackTraceStub{}),
+			expected: []string{"main.main", "runtime.main"},
+		},
+		{
+			name:     "Formatted",
+			err:      errors.Join(sql.ErrNoRows, formattedStackTraceStub{}),
+			expected: []string{"main", "main.go:1"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			stackTrace, ok := StackTrace(test.err)
+			if !ok {
+				t.Fatal("error is supposed to carry a stack trace")
+			}
+
+			if want, have := test.expected, stackTrace; !reflect.DeepEqual(want, have) {
+				t.Errorf("unexpected stack trace\nexpected: %v\nactual:   %v", want, have)
+			}
+		})
+	}
+
+	t.Run("NoStackTrace", func(t *testing.T) {
+		if _, ok := StackTrace(sql.ErrNoRows); ok {
+			t.Error("error is NOT supposed to carry a stack trace")
+		}
+	})
+}
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"errors"
+	"time"
 )
 
 type serviceError interface {
@@ -117,3 +118,247 @@ func IsConflictError(err error) bool {
 
 	return errors.As(err, &e) && e.Conflict()
 }
+
+type unauthenticated interface {
+	Unauthenticated() bool
+}
+
+// IsUnauthenticatedError checks if an error is related to a missing or invalid authentication.
+// An error is considered to be an Unauthenticated error if it implements the following interface:
+//
+//	type unauthenticated interface {
+//		Unauthenticated() bool
+//	}
+//
+// and `Unauthenticated` returns true.
+func IsUnauthenticatedError(err error) bool {
+	var e unauthenticated
+
+	return errors.As(err, &e) && e.Unauthenticated()
+}
+
+type permissionDenied interface {
+	PermissionDenied() bool
+}
+
+// IsPermissionDeniedError checks if an error is related to insufficient permissions.
+// An error is considered to be a PermissionDenied error if it implements the following interface:
+//
+//	type permissionDenied interface {
+//		PermissionDenied() bool
+//	}
+//
+// and `PermissionDenied` returns true.
+func IsPermissionDeniedError(err error) bool {
+	var e permissionDenied
+
+	return errors.As(err, &e) && e.PermissionDenied()
+}
+
+type tooManyRequests interface {
+	TooManyRequests() bool
+}
+
+// IsTooManyRequestsError checks if an error is related to a rate limit or a quota being exhausted.
+// An error is considered to be a TooManyRequests error if it implements the following interface:
+//
+//	type tooManyRequests interface {
+//		TooManyRequests() bool
+//	}
+//
+// and `TooManyRequests` returns true.
+func IsTooManyRequestsError(err error) bool {
+	var e tooManyRequests
+
+	return errors.As(err, &e) && e.TooManyRequests()
+}
+
+type retryAfter interface {
+	RetryAfter() time.Duration
+}
+
+// RetryAfter returns the duration a client should wait before retrying a failed operation.
+// An error carries retry information if it implements the following interface:
+//
+//	type retryAfter interface {
+//		RetryAfter() time.Duration
+//	}
+//
+// and `RetryAfter` returns a positive duration.
+func RetryAfter(err error) (time.Duration, bool) {
+	var e retryAfter
+
+	if errors.As(err, &e) {
+		if d := e.RetryAfter(); d > 0 {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+type unavailable interface {
+	Unavailable() bool
+}
+
+// IsUnavailableError checks if an error is related to a service being temporarily unavailable.
+// An error is considered to be an Unavailable error if it implements the following interface:
+//
+//	type unavailable interface {
+//		Unavailable() bool
+//	}
+//
+// and `Unavailable` returns true.
+func IsUnavailableError(err error) bool {
+	var e unavailable
+
+	return errors.As(err, &e) && e.Unavailable()
+}
+
+type timeout interface {
+	TimeoutError() bool
+}
+
+// IsTimeoutError checks if an error is related to an operation timing out.
+// An error is considered to be a Timeout error if it implements the following interface:
+//
+//	type timeout interface {
+//		TimeoutError() bool
+//	}
+//
+// and `TimeoutError` returns true.
+//
+// Errors implementing the Timeout() bool method of the standard library (eg. net.Error or os.ErrDeadlineExceeded)
+// are NOT considered to be Timeout errors: they usually describe internal failures
+// that should not be exposed to clients as they are.
+func IsTimeoutError(err error) bool {
+	var e timeout
+
+	return errors.As(err, &e) && e.TimeoutError()
+}
+
+type preconditionFailed interface {
+	PreconditionFailed() bool
+}
+
+// IsPreconditionFailedError checks if an error is related to a precondition of the request not being met.
+// An error is considered to be a PreconditionFailed error if it implements the following interface:
+//
+//	type preconditionFailed interface {
+//		PreconditionFailed() bool
+//	}
+//
+// and `PreconditionFailed` returns true.
+func IsPreconditionFailedError(err error) bool {
+	var e preconditionFailed
+
+	return errors.As(err, &e) && e.PreconditionFailed()
+}
+
+type alreadyExists interface {
+	AlreadyExists() bool
+}
+
+// IsAlreadyExistsError checks if an error is related to a resource already existing.
+// An error is considered to be an AlreadyExists error if it implements the following interface:
+//
+//	type alreadyExists interface {
+//		AlreadyExists() bool
+//	}
+//
+// and `AlreadyExists` returns true.
+func IsAlreadyExistsError(err error) bool {
+	var e alreadyExists
+
+	return errors.As(err, &e) && e.AlreadyExists()
+}
+
+type notImplemented interface {
+	NotImplemented() bool
+}
+
+// IsNotImplementedError checks if an error is related to an operation not being implemented.
+// An error is considered to be a NotImplemented error if it implements the following interface:
+//
+//	type notImplemented interface {
+//		NotImplemented() bool
+//	}
+//
+// and `NotImplemented` returns true.
+func IsNotImplementedError(err error) bool {
+	var e notImplemented
+
+	return errors.As(err, &e) && e.NotImplemented()
+}
+
+type errorCode interface {
+	ErrorCode() string
+}
+
+// ErrorCode returns a stable, machine-readable code identifying an error.
+// An error carries an error code if it implements the following interface:
+//
+//	type errorCode interface {
+//		ErrorCode() string
+//	}
+//
+// and `ErrorCode` returns a non-empty string.
+func ErrorCode(err error) (string, bool) {
+	var e errorCode
+
+	if errors.As(err, &e) {
+		if code := e.ErrorCode(); code != "" {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+type details interface {
+	Details() map[string]interface{}
+}
+
+// Details returns structured context information attached to an error.
+// An error carries details if it implements the following interface:
+//
+//	type details interface {
+//		Details() map[string]interface{}
+//	}
+//
+// and `Details` returns a non-empty map.
+func Details(err error) (map[string]interface{}, bool) {
+	var e details
+
+	if errors.As(err, &e) {
+		if d := e.Details(); len(d) > 0 {
+			return d, true
+		}
+	}
+
+	return nil, false
+}
+
+type publicMessage interface {
+	PublicMessage() string
+}
+
+// PublicMessage returns a message describing an error that is safe to expose to clients.
+// An error carries a public message if it implements the following interface:
+//
+//	type publicMessage interface {
+//		PublicMessage() string
+//	}
+//
+// and `PublicMessage` returns a non-empty string.
+func PublicMessage(err error) (string, bool) {
+	var e publicMessage
+
+	if errors.As(err, &e) {
+		if message := e.PublicMessage(); message != "" {
+			return message, true
+		}
+	}
+
+	return "", false
+}
@@ -1,8 +1,14 @@
 package errors
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"os"
+	"reflect"
 	"testing"
+	"time"
 )
 
 type serviceErrorStub struct{}
@@ -274,3 +280,587 @@ func TestIsConflictError(t *testing.T) {
 		}
 	})
 }
+
+type unauthenticatedStub struct{}
+
+func (unauthenticatedStub) Error() string {
+	return ""
+}
+
+func (unauthenticatedStub) Unauthenticated() bool {
+	return true
+}
+
+type nonUnauthenticatedStub struct{}
+
+func (c nonUnauthenticatedStub) Error() string {
+	return ""
+}
+
+func (c nonUnauthenticatedStub) Unauthenticated() bool {
+	return false
+}
+
+func TestIsUnauthenticatedError(t *testing.T) {
+	t.Run("Unauthenticated", func(t *testing.T) {
+		if !IsUnauthenticatedError(unauthenticatedStub{}) {
+			t.Error("error is supposed to be an Unauthenticated error")
+		}
+	})
+
+	t.Run("NonUnauthenticated", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonUnauthenticatedStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsUnauthenticatedError(err) {
+					t.Error("error is NOT supposed to be an Unauthenticated error")
+				}
+			})
+		}
+	})
+}
+
+type permissionDeniedStub struct{}
+
+func (permissionDeniedStub) Error() string {
+	return ""
+}
+
+func (permissionDeniedStub) PermissionDenied() bool {
+	return true
+}
+
+type nonPermissionDeniedStub struct{}
+
+func (c nonPermissionDeniedStub) Error() string {
+	return ""
+}
+
+func (c nonPermissionDeniedStub) PermissionDenied() bool {
+	return false
+}
+
+func TestIsPermissionDeniedError(t *testing.T) {
+	t.Run("PermissionDenied", func(t *testing.T) {
+		if !IsPermissionDeniedError(permissionDeniedStub{}) {
+			t.Error("error is supposed to be a PermissionDenied error")
+		}
+	})
+
+	t.Run("NonPermissionDenied", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonPermissionDeniedStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsPermissionDeniedError(err) {
+					t.Error("error is NOT supposed to be a PermissionDenied error")
+				}
+			})
+		}
+	})
+}
+
+type tooManyRequestsStub struct{}
+
+func (tooManyRequestsStub) Error() string {
+	return ""
+}
+
+func (tooManyRequestsStub) TooManyRequests() bool {
+	return true
+}
+
+type nonTooManyRequestsStub struct{}
+
+func (c nonTooManyRequestsStub) Error() string {
+	return ""
+}
+
+func (c nonTooManyRequestsStub) TooManyRequests() bool {
+	return false
+}
+
+func TestIsTooManyRequestsError(t *testing.T) {
+	t.Run("TooManyRequests", func(t *testing.T) {
+		if !IsTooManyRequestsError(tooManyRequestsStub{}) {
+			t.Error("error is supposed to be a TooManyRequests error")
+		}
+	})
+
+	t.Run("NonTooManyRequests", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonTooManyRequestsStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsTooManyRequestsError(err) {
+					t.Error("error is NOT supposed to be a TooManyRequests error")
+				}
+			})
+		}
+	})
+}
+
+type retryAfterStub struct {
+	retryAfter time.Duration
+}
+
+func (retryAfterStub) Error() string {
+	return ""
+}
+
+func (s retryAfterStub) RetryAfter() time.Duration {
+	return s.retryAfter
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Run("RetryAfter", func(t *testing.T) {
+		d, ok := RetryAfter(fmt.Errorf("wrapped: %w", retryAfterStub{time.Minute}))
+		if !ok {
+			t.Fatal("error is supposed to carry retry information")
+		}
+
+		if want, have := time.Minute, d; want != have {
+			t.Errorf("unexpected duration\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("NoRetryAfter", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			retryAfterStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if _, ok := RetryAfter(err); ok {
+					t.Error("error is NOT supposed to carry retry information")
+				}
+			})
+		}
+	})
+}
+
+type unavailableStub struct{}
+
+func (unavailableStub) Error() string {
+	return ""
+}
+
+func (unavailableStub) Unavailable() bool {
+	return true
+}
+
+type nonUnavailableStub struct{}
+
+func (c nonUnavailableStub) Error() string {
+	return ""
+}
+
+func (c nonUnavailableStub) Unavailable() bool {
+	return false
+}
+
+func TestIsUnavailableError(t *testing.T) {
+	t.Run("Unavailable", func(t *testing.T) {
+		if !IsUnavailableError(unavailableStub{}) {
+			t.Error("error is supposed to be an Unavailable error")
+		}
+	})
+
+	t.Run("NonUnavailable", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonUnavailableStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsUnavailableError(err) {
+					t.Error("error is NOT supposed to be an Unavailable error")
+				}
+			})
+		}
+	})
+}
+
+type timeoutStub struct{}
+
+func (timeoutStub) Error() string {
+	return ""
+}
+
+func (timeoutStub) TimeoutError() bool {
+	return true
+}
+
+type nonTimeoutStub struct{}
+
+func (c nonTimeoutStub) Error() string {
+	return ""
+}
+
+func (c nonTimeoutStub) TimeoutError() bool {
+	return false
+}
+
+func TestIsTimeoutError(t *testing.T) {
+	t.Run("Timeout", func(t *testing.T) {
+		if !IsTimeoutError(timeoutStub{}) {
+			t.Error("error is supposed to be a Timeout error")
+		}
+	})
+
+	t.Run("NonTimeout", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonTimeoutStub{},
+			os.ErrDeadlineExceeded,
+			context.DeadlineExceeded,
+			&net.OpError{Op: "dial", Net: "tcp", Err: os.ErrDeadlineExceeded},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsTimeoutError(err) {
+					t.Error("error is NOT supposed to be a Timeout error")
+				}
+			})
+		}
+	})
+}
+
+type preconditionFailedStub struct{}
+
+func (preconditionFailedStub) Error() string {
+	return ""
+}
+
+func (preconditionFailedStub) PreconditionFailed() bool {
+	return true
+}
+
+type nonPreconditionFailedStub struct{}
+
+func (c nonPreconditionFailedStub) Error() string {
+	return ""
+}
+
+func (c nonPreconditionFailedStub) PreconditionFailed() bool {
+	return false
+}
+
+func TestIsPreconditionFailedError(t *testing.T) {
+	t.Run("PreconditionFailed", func(t *testing.T) {
+		if !IsPreconditionFailedError(preconditionFailedStub{}) {
+			t.Error("error is supposed to be a PreconditionFailed error")
+		}
+	})
+
+	t.Run("NonPreconditionFailed", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonPreconditionFailedStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsPreconditionFailedError(err) {
+					t.Error("error is NOT supposed to be a PreconditionFailed error")
+				}
+			})
+		}
+	})
+}
+
+type alreadyExistsStub struct{}
+
+func (alreadyExistsStub) Error() string {
+	return ""
+}
+
+func (alreadyExistsStub) AlreadyExists() bool {
+	return true
+}
+
+type nonAlreadyExistsStub struct{}
+
+func (c nonAlreadyExistsStub) Error() string {
+	return ""
+}
+
+func (c nonAlreadyExistsStub) AlreadyExists() bool {
+	return false
+}
+
+func TestIsAlreadyExistsError(t *testing.T) {
+	t.Run("AlreadyExists", func(t *testing.T) {
+		if !IsAlreadyExistsError(alreadyExistsStub{}) {
+			t.Error("error is supposed to be an AlreadyExists error")
+		}
+	})
+
+	t.Run("NonAlreadyExists", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonAlreadyExistsStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsAlreadyExistsError(err) {
+					t.Error("error is NOT supposed to be an AlreadyExists error")
+				}
+			})
+		}
+	})
+}
+
+type notImplementedStub struct{}
+
+func (notImplementedStub) Error() string {
+	return ""
+}
+
+func (notImplementedStub) NotImplemented() bool {
+	return true
+}
+
+type nonNotImplementedStub struct{}
+
+func (c nonNotImplementedStub) Error() string {
+	return ""
+}
+
+func (c nonNotImplementedStub) NotImplemented() bool {
+	return false
+}
+
+func TestIsNotImplementedError(t *testing.T) {
+	t.Run("NotImplemented", func(t *testing.T) {
+		if !IsNotImplementedError(notImplementedStub{}) {
+			t.Error("error is supposed to be a NotImplemented error")
+		}
+	})
+
+	t.Run("NonNotImplemented", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			nonNotImplementedStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if IsNotImplementedError(err) {
+					t.Error("error is NOT supposed to be a NotImplemented error")
+				}
+			})
+		}
+	})
+}
+
+type errorCodeStub struct {
+	code string
+}
+
+func (errorCodeStub) Error() string {
+	return ""
+}
+
+func (s errorCodeStub) ErrorCode() string {
+	return s.code
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Run("ErrorCode", func(t *testing.T) {
+		code, ok := ErrorCode(fmt.Errorf("wrapped: %w", errorCodeStub{"USER_NOT_FOUND"}))
+		if !ok {
+			t.Fatal("error is supposed to carry an error code")
+		}
+
+		if want, have := "USER_NOT_FOUND", code; want != have {
+			t.Errorf("unexpected error code\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("NoErrorCode", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			errorCodeStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if _, ok := ErrorCode(err); ok {
+					t.Error("error is NOT supposed to carry an error code")
+				}
+			})
+		}
+	})
+}
+
+type detailsStub struct {
+	details map[string]interface{}
+}
+
+func (detailsStub) Error() string {
+	return ""
+}
+
+func (s detailsStub) Details() map[string]interface{} {
+	return s.details
+}
+
+func TestDetails(t *testing.T) {
+	t.Run("Details", func(t *testing.T) {
+		details, ok := Details(fmt.Errorf("wrapped: %w", detailsStub{map[string]interface{}{"id": 1}}))
+		if !ok {
+			t.Fatal("error is supposed to carry details")
+		}
+
+		if want, have := 1, details["id"]; want != have {
+			t.Errorf("unexpected detail\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+
+	t.Run("NoDetails", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			detailsStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if _, ok := Details(err); ok {
+					t.Error("error is NOT supposed to carry details")
+				}
+			})
+		}
+	})
+}
+
+type publicMessageStub struct {
+	message string
+}
+
+func (publicMessageStub) Error() string {
+	return ""
+}
+
+func (s publicMessageStub) PublicMessage() string {
+	return s.message
+}
+
+func TestPublicMessage(t *testing.T) {
+	t.Run("PublicMessage", func(t *testing.T) {
+		message, ok := PublicMessage(fmt.Errorf("wrapped: %w", publicMessageStub{"user not found"}))
+		if !ok {
+			t.Fatal("error is supposed to carry a public message")
+		}
+
+		if want, have := "user not found", message; want != have {
+			t.Errorf("unexpected public message\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("NoPublicMessage", func(t *testing.T) {
+		tests := []error{
+			errors.New("error"),
+			publicMessageStub{},
+		}
+
+		for _, err := range tests {
+			err := err
+
+			t.Run("", func(t *testing.T) {
+				if _, ok := PublicMessage(err); ok {
+					t.Error("error is NOT supposed to carry a public message")
+				}
+			})
+		}
+	})
+}
+
+type violationsStub struct {
+	violations map[string][]string
+}
+
+func (violationsStub) Error() string {
+	return ""
+}
+
+func (s violationsStub) Violations() map[string][]string {
+	return s.violations
+}
+
+func TestViolations(t *testing.T) {
+	t.Run("Violations", func(t *testing.T) {
+		violations, ok := Violations(fmt.Errorf("wrapped: %w", violationsStub{map[string][]string{"email": {"required"}}}))
+		if !ok {
+			t.Fatal("error is supposed to carry violations")
+		}
+
+		if want, have := map[string][]string{"email": {"required"}}, violations; !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+
+	t.Run("Joined", func(t *testing.T) {
+		err := errors.Join(
+			violationsStub{map[string][]string{"email": {"required"}}},
+			errors.New("error"),
+			fmt.Errorf("wrapped: %w", violationsStub{map[string][]string{"email": {"invalid"}, "name": {"required"}}}),
+		)
+
+		violations, ok := Violations(err)
+		if !ok {
+			t.Fatal("error is supposed to carry violations")
+		}
+
+		expected := map[string][]string{
+			"email": {"required", "invalid"},
+			"name":  {"required"},
+		}
+
+		if want, have := expected, violations; !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+
+	t.Run("NoViolations", func(t *testing.T) {
+		if _, ok := Violations(errors.Join(errors.New("error"), nil)); ok {
+			t.Error("error is NOT supposed to carry violations")
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"errors"
+	"regexp"
+)
+
+// The functions below build error matchers (functions checking if an error matches a certain condition).
+// Matchers are plain functions, so they can be used wherever a func(err error) bool is expected
+// (eg. the ErrorMatcher types of the transport packages).
+
+// MatchAny returns a matcher that matches an error if any of the matchers match it.
+func MatchAny(matchers ...func(err error) bool) func(err error) bool {
+	return func(err error) bool {
+		for _, matcher := range matchers {
+			if matcher(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// MatchAll returns a matcher that matches an error if all of the matchers match it.
+func MatchAll(matchers ...func(err error) bool) func(err error) bool {
+	return func(err error) bool {
+		for _, matcher := range matchers {
+			if !matcher(err) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Not returns a matcher that matches an error if matcher does not match it.
+func Not(matcher func(err error) bool) func(err error) bool {
+	return func(err error) bool {
+		return !matcher(err)
+	}
+}
+
+// MatchIs returns a matcher that matches an error if any error in its tree matches target (see errors.Is).
+func MatchIs(target error) func(err error) bool {
+	return func(err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
+// MatchAs returns a matcher that matches an error if any error in its tree is of type T (see errors.As).
+func MatchAs[T error]() func(err error) bool {
+	return func(err error) bool {
+		var target T
+
+		return errors.As(err, &target)
+	}
+}
+
+// MatchMessage returns a matcher that matches an error if any error in its Unwrap chain (see Chain)
+// has exactly the given message.
+func MatchMessage(message string) func(err error) bool {
+	return func(err error) bool {
+		for _, e := range Chain(err) {
+			if e.Error() == message {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// MatchRegexp returns a matcher that matches an error if its (full) message matches a regular expression.
+func MatchRegexp(re *regexp.Regexp) func(err error) bool {
+	return func(err error) bool {
+		return err != nil && re.MatchString(err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+package errors
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"testing"
+)
+
+func TestMatchers(t *testing.T) {
+	wrapped := fmt.Errorf("query user: %w", sql.ErrNoRows)
+	pathErr := fmt.Errorf("open config: %w", &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist})
+
+	tests := []struct {
+		name     string
+		matcher  func(err error) bool
+		err      error
+		expected bool
+	}{
+		{"MatchIs", MatchIs(sql.ErrNoRows), wrapped, true},
+		{"MatchIs/NoMatch", MatchIs(context.DeadlineExceeded), wrapped, false},
+		{"MatchAs", MatchAs[*fs.PathError](), pathErr, true},
+		{"MatchAs/NoMatch", MatchAs[*fs.PathError](), wrapped, false},
+		{"MatchMessage", MatchMessage(sql.ErrNoRows.Error()), wrapped, true},
+		{"MatchMessage/NoMatch", MatchMessage("query user"), wrapped, false},
+		{"MatchRegexp", MatchRegexp(regexp.MustCompile(`^query \w+:`)), wrapped, true},
+		{"MatchRegexp/NoMatch", MatchRegexp(regexp.MustCompile(`^open`)), wrapped, false},
+		{"MatchAny", MatchAny(MatchIs(context.Canceled), MatchIs(sql.ErrNoRows)), wrapped, true},
+		{"MatchAny/NoMatch", MatchAny(MatchIs(context.Canceled), MatchIs(context.DeadlineExceeded)), wrapped, false},
+		{"MatchAny/Empty", MatchAny(), wrapped, false},
+		{"MatchAll", MatchAll(MatchIs(sql.ErrNoRows), MatchMessage(wrapped.Error())), wrapped, true},
+		{"MatchAll/NoMatch", MatchAll(MatchIs(sql.ErrNoRows), MatchIs(fs.ErrNotExist)), wrapped, false},
+		{"Not", Not(MatchIs(fs.ErrNotExist)), wrapped, true},
+		{"Not/NoMatch", Not(MatchIs(sql.ErrNoRows)), wrapped, false},
+		{"Behavior", MatchAll(IsNotFoundError, Not(MatchIs(sql.ErrNoRows))), NewNotFound("user", 1), true},
+		{"Nil", MatchAny(MatchIs(sql.ErrNoRows), MatchMessage(""), MatchRegexp(regexp.MustCompile(".*"))), nil, false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if want, have := test.expected, test.matcher(test.err); want != have {
+				t.Errorf("unexpected match result\nexpected: %t\nactual:   %t", want, have)
+			}
+		})
+	}
+}
+
+func TestMatchers_ErrorsJoin(t *testing.T) {
+	err := errors.Join(errors.New("first"), sql.ErrNoRows)
+
+	if !MatchMessage("first")(err) {
+		t.Error("error is supposed to match a message of a joined error")
+	}
+
+	if !MatchIs(sql.ErrNoRows)(err) {
+		t.Error("error is supposed to match a joined error")
+	}
+}
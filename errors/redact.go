@@ -0,0 +1,62 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+)
+
+// Redactor returns a message describing an error that is safe to expose to clients.
+//
+// Transports use redactors to avoid leaking internal context
+// (eg. SQL fragments, hostnames) carried by the causes wrapped by an error.
+type Redactor func(err error) string
+
+// FullMessage returns the complete message of an error, including the messages of every wrapped cause.
+// It is meant to be used in non-production environments for debugging purposes.
+func FullMessage(err error) string {
+	return err.Error()
+}
+
+// OuterMessage returns the message of the outermost error in the Unwrap chain
+// without the messages of the wrapped causes.
+//
+// The message of a wrapped cause is removed if the message of the wrapping error ends with ": " followed by it
+// (as created by fmt.Errorf("...: %w", err)).
+// Errors repeating the message of the cause (eg. errors decorated with a behavior) are skipped.
+func OuterMessage(err error) string {
+	for {
+		message := err.Error()
+
+		cause := errors.Unwrap(err)
+		if cause == nil {
+			return message
+		}
+
+		causeMessage := cause.Error()
+
+		if message == causeMessage {
+			err = cause
+
+			continue
+		}
+
+		return strings.TrimSuffix(message, ": "+causeMessage)
+	}
+}
+
+// PublicMessageRedactor returns a Redactor that uses the public message of an error (see PublicMessage)
+// and falls back to another Redactor if the error does not carry a public message.
+// If fallback is nil, OuterMessage is used.
+func PublicMessageRedactor(fallback Redactor) Redactor {
+	if fallback == nil {
+		fallback = OuterMessage
+	}
+
+	return func(err error) string {
+		if message, ok := PublicMessage(err); ok {
+			return message
+		}
+
+		return fallback(err)
+	}
+}
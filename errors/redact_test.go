@@ -0,0 +1,87 @@
+package errors
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestOuterMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "Simple",
+			err:      errors.New("error"),
+			expected: "error",
+		},
+		{
+			name:     "Wrapped",
+			err:      fmt.Errorf("user not found: %w", sql.ErrNoRows),
+			expected: "user not found",
+		},
+		{
+			name:     "Decorated",
+			err:      WithNotFound(fmt.Errorf("user not found: %w", fmt.Errorf("query: %w", sql.ErrNoRows))),
+			expected: "user not found",
+		},
+		{
+			name:     "Unrelated",
+			err:      fmt.Errorf("user not found (%w)", sql.ErrNoRows),
+			expected: "user not found (" + sql.ErrNoRows.Error() + ")",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if want, have := test.expected, OuterMessage(test.err); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestPublicMessageRedactor(t *testing.T) {
+	err := fmt.Errorf("user not found: %w", sql.ErrNoRows)
+
+	tests := []struct {
+		name     string
+		redactor Redactor
+		err      error
+		expected string
+	}{
+		{
+			name:     "PublicMessage",
+			redactor: PublicMessageRedactor(nil),
+			err:      WithPublicMessage(err, "the user does not exist"),
+			expected: "the user does not exist",
+		},
+		{
+			name:     "DefaultFallback",
+			redactor: PublicMessageRedactor(nil),
+			err:      err,
+			expected: "user not found",
+		},
+		{
+			name:     "Fallback",
+			redactor: PublicMessageRedactor(FullMessage),
+			err:      err,
+			expected: err.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if want, have := test.expected, test.redactor(test.err); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package errors
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldViolation describes a single validation violation of a field.
+type FieldViolation struct {
+	// Path is the path of the invalid field (eg. []string{"addresses", "0", "city"}).
+	Path []string
+
+	// Code is a stable, machine-readable code identifying the violated rule (eg. "required").
+	Code string
+
+	// Message is a human-readable explanation of the violation.
+	Message string
+
+	// Params contains the parameters of the violated rule (eg. the minimum length of a field).
+	Params map[string]interface{}
+}
+
+// Field returns the path of the invalid field joined with dots (eg. "addresses.0.city").
+func (v FieldViolation) Field() string {
+	return strings.Join(v.Path, ".")
+}
+
+// FieldViolationsFromMap converts violations grouped by field to a list of field violations.
+// Violations are ordered by field name (preserving the order of violations of the same field)
+// and each field name becomes a single path segment.
+func FieldViolationsFromMap(violations map[string][]string) []FieldViolation {
+	fields := make([]string, 0, len(violations))
+	for field := range violations {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	var fieldViolations []FieldViolation
+
+	for _, field := range fields {
+		for _, message := range violations[field] {
+			fieldViolations = append(fieldViolations, FieldViolation{
+				Path:    []string{field},
+				Message: message,
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+// FieldViolationsToMap converts a list of field violations to violations grouped by field (see FieldViolation.Field).
+// The order of violations of the same field is preserved.
+func FieldViolationsToMap(fieldViolations []FieldViolation) map[string][]string {
+	violations := make(map[string][]string, len(fieldViolations))
+
+	for _, violation := range fieldViolations {
+		violations[violation.Field()] = append(violations[violation.Field()], violation.Message)
+	}
+
+	return violations
+}
+
+type violations interface {
+	Violations() map[string][]string
+}
+
+// Violations returns the validation violations (grouped by field) carried by an error.
+// An error carries violations if it implements the following interface:
+//
+//	type violations interface {
+//		Violations() map[string][]string
+//	}
+//
+// Errors only carrying field violations (see FieldViolations) are converted
+// using the dot-separated path of each field as the key.
+//
+// Unlike errors.As, Violations walks every branch of joined errors (see errors.Join)
+// and merges the violations of every error carrying violations.
+// Errors wrapped by an error carrying violations are not inspected.
+func Violations(err error) (map[string][]string, bool) {
+	var merged map[string][]string
+
+	found := walkViolations(err, func(err error) bool {
+		var v map[string][]string
+
+		switch e := err.(type) {
+		case violations:
+			v = e.Violations()
+
+		case fieldViolations:
+			v = FieldViolationsToMap(e.FieldViolations())
+
+		default:
+			return false
+		}
+
+		for field, fieldViolations := range v {
+			if merged == nil {
+				merged = make(map[string][]string)
+			}
+
+			merged[field] = append(merged[field], fieldViolations...)
+		}
+
+		return true
+	})
+
+	return merged, found
+}
+
+type fieldViolations interface {
+	FieldViolations() []FieldViolation
+}
+
+// FieldViolations returns the ordered list of validation violations carried by an error.
+// An error carries field violations if it implements the following interface:
+//
+//	type fieldViolations interface {
+//		FieldViolations() []FieldViolation
+//	}
+//
+// Errors only carrying violations grouped by field (see Violations) are converted using FieldViolationsFromMap.
+//
+// Unlike errors.As, FieldViolations walks every branch of joined errors (see errors.Join)
+// and concatenates the violations of every error carrying violations.
+// Errors wrapped by an error carrying violations are not inspected.
+func FieldViolations(err error) ([]FieldViolation, bool) {
+	var merged []FieldViolation
+
+	found := walkViolations(err, func(err error) bool {
+		switch e := err.(type) {
+		case fieldViolations:
+			merged = append(merged, e.FieldViolations()...)
+
+		case violations:
+			merged = append(merged, FieldViolationsFromMap(e.Violations())...)
+
+		default:
+			return false
+		}
+
+		return true
+	})
+
+	return merged, found
+}
+
+// walkViolations walks the tree of errors (following both Unwrap() error and Unwrap() []error) in depth-first order.
+// If visit returns true, the errors wrapped by the visited error are not inspected.
+// walkViolations returns true if visit returned true for any error.
+func walkViolations(err error, visit func(err error) bool) bool {
+	if err == nil {
+		return false
+	}
+
+	if visit(err) {
+		return true
+	}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		return walkViolations(e.Unwrap(), visit)
+
+	case interface{ Unwrap() []error }:
+		var found bool
+
+		for _, cause := range e.Unwrap() {
+			if walkViolations(cause, visit) {
+				found = true
+			}
+		}
+
+		return found
+	}
+
+	return false
+}
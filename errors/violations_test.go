@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFieldViolationsFromMap(t *testing.T) {
+	fieldViolations := FieldViolationsFromMap(map[string][]string{
+		"name":      {"required"},
+		"email":     {"required", "invalid"},
+		"addresses": nil,
+	})
+
+	expected := []FieldViolation{
+		{Path: []string{"email"}, Message: "required"},
+		{Path: []string{"email"}, Message: "invalid"},
+		{Path: []string{"name"}, Message: "required"},
+	}
+
+	if want, have := expected, fieldViolations; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected field violations\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestFieldViolations(t *testing.T) {
+	t.Run("Joined", func(t *testing.T) {
+		err := errors.Join(
+			NewFieldValidation("invalid user", []FieldViolation{
+				{Path: []string{"name"}, Code: "required", Message: "required"},
+			}),
+			NewValidation("invalid user", map[string][]string{"email": {"invalid"}}),
+		)
+
+		fieldViolations, ok := FieldViolations(err)
+		if !ok {
+			t.Fatal("error is supposed to carry field violations")
+		}
+
+		expected := []FieldViolation{
+			{Path: []string{"name"}, Code: "required", Message: "required"},
+			{Path: []string{"email"}, Message: "invalid"},
+		}
+
+		if want, have := expected, fieldViolations; !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected field violations\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+
+	t.Run("NoFieldViolations", func(t *testing.T) {
+		if _, ok := FieldViolations(errors.New("error")); ok {
+			t.Error("error is NOT supposed to carry field violations")
+		}
+	})
+}
@@ -0,0 +1,368 @@
+package errors
+
+import (
+	"time"
+)
+
+// behaviorWrapper is the common base of errors decorated with a behavior by the helpers in this package.
+// It keeps the original error in the Unwrap chain.
+type behaviorWrapper struct {
+	error
+}
+
+func (e behaviorWrapper) Unwrap() error {
+	return e.error
+}
+
+type withServiceError struct {
+	behaviorWrapper
+}
+
+func (withServiceError) ServiceError() bool {
+	return true
+}
+
+// AsServiceError marks an error to be returned to the client for processing.
+// If err is nil, AsServiceError returns nil.
+func AsServiceError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withServiceError{behaviorWrapper{err}}
+}
+
+type withNotFound struct {
+	behaviorWrapper
+}
+
+func (withNotFound) NotFound() bool {
+	return true
+}
+
+// WithNotFound decorates an error with the NotFound behavior (ie. the error is related to a resource not being found).
+// If err is nil, WithNotFound returns nil.
+func WithNotFound(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withNotFound{behaviorWrapper{err}}
+}
+
+type withValidation struct {
+	behaviorWrapper
+
+	violations map[string][]string
+}
+
+func (withValidation) Validation() bool {
+	return true
+}
+
+func (e withValidation) Violations() map[string][]string {
+	return e.violations
+}
+
+// WithValidation decorates an error with the Validation behavior and a list of violations for each field.
+// If err is nil, WithValidation returns nil.
+func WithValidation(err error, violations map[string][]string) error {
+	if err == nil {
+		return nil
+	}
+
+	return withValidation{
+		behaviorWrapper: behaviorWrapper{err},
+		violations:      violations,
+	}
+}
+
+type withFieldValidation struct {
+	behaviorWrapper
+
+	violations []FieldViolation
+}
+
+func (withFieldValidation) Validation() bool {
+	return true
+}
+
+func (e withFieldValidation) FieldViolations() []FieldViolation {
+	return e.violations
+}
+
+func (e withFieldValidation) Violations() map[string][]string {
+	return FieldViolationsToMap(e.violations)
+}
+
+// WithFieldValidation decorates an error with the Validation behavior and an ordered list of field violations.
+// If err is nil, WithFieldValidation returns nil.
+func WithFieldValidation(err error, violations []FieldViolation) error {
+	if err == nil {
+		return nil
+	}
+
+	return withFieldValidation{
+		behaviorWrapper: behaviorWrapper{err},
+		violations:      violations,
+	}
+}
+
+type withBadRequest struct {
+	behaviorWrapper
+}
+
+func (withBadRequest) BadRequest() bool {
+	return true
+}
+
+// WithBadRequest decorates an error with the BadRequest behavior (ie. the error is related to a bad request being made).
+// If err is nil, WithBadRequest returns nil.
+func WithBadRequest(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withBadRequest{behaviorWrapper{err}}
+}
+
+type withConflict struct {
+	behaviorWrapper
+}
+
+func (withConflict) Conflict() bool {
+	return true
+}
+
+// WithConflict decorates an error with the Conflict behavior (ie. the error is related to a resource conflict).
+// If err is nil, WithConflict returns nil.
+func WithConflict(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withConflict{behaviorWrapper{err}}
+}
+
+type withUnauthenticated struct {
+	behaviorWrapper
+}
+
+func (withUnauthenticated) Unauthenticated() bool {
+	return true
+}
+
+// WithUnauthenticated decorates an error with the Unauthenticated behavior (ie. the error is related to a missing or invalid authentication).
+// If err is nil, WithUnauthenticated returns nil.
+func WithUnauthenticated(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withUnauthenticated{behaviorWrapper{err}}
+}
+
+type withPermissionDenied struct {
+	behaviorWrapper
+}
+
+func (withPermissionDenied) PermissionDenied() bool {
+	return true
+}
+
+// WithPermissionDenied decorates an error with the PermissionDenied behavior (ie. the error is related to insufficient permissions).
+// If err is nil, WithPermissionDenied returns nil.
+func WithPermissionDenied(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withPermissionDenied{behaviorWrapper{err}}
+}
+
+type withTooManyRequests struct {
+	behaviorWrapper
+
+	retryAfter time.Duration
+}
+
+func (withTooManyRequests) TooManyRequests() bool {
+	return true
+}
+
+func (e withTooManyRequests) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// WithTooManyRequests decorates an error with the TooManyRequests behavior
+// telling the client to retry after the specified duration (if positive).
+// If err is nil, WithTooManyRequests returns nil.
+func WithTooManyRequests(err error, retryAfter time.Duration) error {
+	if err == nil {
+		return nil
+	}
+
+	return withTooManyRequests{
+		behaviorWrapper: behaviorWrapper{err},
+		retryAfter:      retryAfter,
+	}
+}
+
+type withUnavailable struct {
+	behaviorWrapper
+}
+
+func (withUnavailable) Unavailable() bool {
+	return true
+}
+
+// WithUnavailable decorates an error with the Unavailable behavior (ie. the error is related to a service being temporarily unavailable).
+// If err is nil, WithUnavailable returns nil.
+func WithUnavailable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withUnavailable{behaviorWrapper{err}}
+}
+
+type withTimeout struct {
+	behaviorWrapper
+}
+
+func (withTimeout) TimeoutError() bool {
+	return true
+}
+
+// WithTimeout decorates an error with the Timeout behavior (ie. the error is related to an operation timing out).
+// If err is nil, WithTimeout returns nil.
+func WithTimeout(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withTimeout{behaviorWrapper{err}}
+}
+
+type withPreconditionFailed struct {
+	behaviorWrapper
+}
+
+func (withPreconditionFailed) PreconditionFailed() bool {
+	return true
+}
+
+// WithPreconditionFailed decorates an error with the PreconditionFailed behavior (ie. the error is related to a precondition of the request not being met).
+// If err is nil, WithPreconditionFailed returns nil.
+func WithPreconditionFailed(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withPreconditionFailed{behaviorWrapper{err}}
+}
+
+type withAlreadyExists struct {
+	behaviorWrapper
+}
+
+func (withAlreadyExists) AlreadyExists() bool {
+	return true
+}
+
+// WithAlreadyExists decorates an error with the AlreadyExists behavior (ie. the error is related to a resource already existing).
+// If err is nil, WithAlreadyExists returns nil.
+func WithAlreadyExists(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withAlreadyExists{behaviorWrapper{err}}
+}
+
+type withNotImplemented struct {
+	behaviorWrapper
+}
+
+func (withNotImplemented) NotImplemented() bool {
+	return true
+}
+
+// WithNotImplemented decorates an error with the NotImplemented behavior (ie. the error is related to an operation not being implemented).
+// If err is nil, WithNotImplemented returns nil.
+func WithNotImplemented(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withNotImplemented{behaviorWrapper{err}}
+}
+
+type withErrorCode struct {
+	behaviorWrapper
+
+	code string
+}
+
+func (e withErrorCode) ErrorCode() string {
+	return e.code
+}
+
+// WithErrorCode decorates an error with a stable, machine-readable error code.
+// If err is nil, WithErrorCode returns nil.
+func WithErrorCode(err error, code string) error {
+	if err == nil {
+		return nil
+	}
+
+	return withErrorCode{
+		behaviorWrapper: behaviorWrapper{err},
+		code:            code,
+	}
+}
+
+type withDetails struct {
+	behaviorWrapper
+
+	details map[string]interface{}
+}
+
+func (e withDetails) Details() map[string]interface{} {
+	return e.details
+}
+
+// WithDetails decorates an error with structured context information.
+// If err is nil, WithDetails returns nil.
+func WithDetails(err error, details map[string]interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	return withDetails{
+		behaviorWrapper: behaviorWrapper{err},
+		details:         details,
+	}
+}
+
+type withPublicMessage struct {
+	behaviorWrapper
+
+	message string
+}
+
+func (e withPublicMessage) PublicMessage() string {
+	return e.message
+}
+
+// WithPublicMessage decorates an error with a message that is safe to expose to clients.
+// If err is nil, WithPublicMessage returns nil.
+func WithPublicMessage(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	return withPublicMessage{
+		behaviorWrapper: behaviorWrapper{err},
+		message:         message,
+	}
+}
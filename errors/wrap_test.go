@@ -0,0 +1,224 @@
+package errors
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrap    func(err error) error
+		matcher func(err error) bool
+	}{
+		{
+			name:    "ServiceError",
+			wrap:    AsServiceError,
+			matcher: IsServiceError,
+		},
+		{
+			name:    "NotFound",
+			wrap:    WithNotFound,
+			matcher: IsNotFoundError,
+		},
+		{
+			name:    "Validation",
+			wrap:    func(err error) error { return WithValidation(err, nil) },
+			matcher: IsValidationError,
+		},
+		{
+			name:    "BadRequest",
+			wrap:    WithBadRequest,
+			matcher: IsBadRequestError,
+		},
+		{
+			name:    "Conflict",
+			wrap:    WithConflict,
+			matcher: IsConflictError,
+		},
+		{
+			name:    "Unauthenticated",
+			wrap:    WithUnauthenticated,
+			matcher: IsUnauthenticatedError,
+		},
+		{
+			name:    "PermissionDenied",
+			wrap:    WithPermissionDenied,
+			matcher: IsPermissionDeniedError,
+		},
+		{
+			name:    "TooManyRequests",
+			wrap:    func(err error) error { return WithTooManyRequests(err, 0) },
+			matcher: IsTooManyRequestsError,
+		},
+		{
+			name:    "Unavailable",
+			wrap:    WithUnavailable,
+			matcher: IsUnavailableError,
+		},
+		{
+			name:    "Timeout",
+			wrap:    WithTimeout,
+			matcher: IsTimeoutError,
+		},
+		{
+			name:    "PreconditionFailed",
+			wrap:    WithPreconditionFailed,
+			matcher: IsPreconditionFailedError,
+		},
+		{
+			name:    "AlreadyExists",
+			wrap:    WithAlreadyExists,
+			matcher: IsAlreadyExistsError,
+		},
+		{
+			name:    "NotImplemented",
+			wrap:    WithNotImplemented,
+			matcher: IsNotImplementedError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", test.wrap(sql.ErrNoRows))
+
+			if !test.matcher(err) {
+				t.Errorf("error is supposed to be a %s error", test.name)
+			}
+
+			if !errors.Is(err, sql.ErrNoRows) {
+				t.Error("error is supposed to wrap the original error")
+			}
+
+			if want, have := "wrapped: "+sql.ErrNoRows.Error(), err.Error(); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+
+			if test.wrap(nil) != nil {
+				t.Error("wrapping a nil error is supposed to return nil")
+			}
+		})
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	err := WithValidation(errors.New("invalid"), map[string][]string{
+		"email": {"required"},
+	})
+
+	var verr interface {
+		Violations() map[string][]string
+	}
+
+	if !errors.As(err, &verr) {
+		t.Fatal("error is supposed to carry violations")
+	}
+
+	if want, have := "required", verr.Violations()["email"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestWithFieldValidation(t *testing.T) {
+	err := WithFieldValidation(errors.New("invalid"), []FieldViolation{
+		{Path: []string{"email"}, Code: "required", Message: "required"},
+	})
+
+	if !IsValidationError(err) {
+		t.Error("error is supposed to be a Validation error")
+	}
+
+	fieldViolations, ok := FieldViolations(err)
+	if !ok {
+		t.Fatal("error is supposed to carry field violations")
+	}
+
+	if want, have := "required", fieldViolations[0].Code; want != have {
+		t.Errorf("unexpected code\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if WithFieldValidation(nil, nil) != nil {
+		t.Error("wrapping a nil error is supposed to return nil")
+	}
+}
+
+func TestWithTooManyRequests(t *testing.T) {
+	err := WithTooManyRequests(errors.New("rate limited"), time.Minute)
+
+	retryAfter, ok := RetryAfter(err)
+	if !ok {
+		t.Fatal("error is supposed to carry retry information")
+	}
+
+	if want, have := time.Minute, retryAfter; want != have {
+		t.Errorf("unexpected duration\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func ExampleWithNotFound() {
+	err := AsServiceError(WithNotFound(sql.ErrNoRows))
+
+	fmt.Println(err, IsNotFoundError(err), IsServiceError(err))
+
+	// Output: sql: no rows in result set true true
+}
+
+func TestWithErrorCode(t *testing.T) {
+	err := WithErrorCode(WithNotFound(sql.ErrNoRows), "USER_NOT_FOUND")
+
+	code, ok := ErrorCode(err)
+	if !ok {
+		t.Fatal("error is supposed to carry an error code")
+	}
+
+	if want, have := "USER_NOT_FOUND", code; want != have {
+		t.Errorf("unexpected error code\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if !IsNotFoundError(err) {
+		t.Error("error is supposed to keep the behaviors of the original error")
+	}
+}
+
+func TestWithDetails(t *testing.T) {
+	err := WithDetails(WithNotFound(sql.ErrNoRows), map[string]interface{}{"id": 1})
+
+	details, ok := Details(err)
+	if !ok {
+		t.Fatal("error is supposed to carry details")
+	}
+
+	if want, have := 1, details["id"]; want != have {
+		t.Errorf("unexpected detail\nexpected: %v\nactual:   %v", want, have)
+	}
+
+	if !IsNotFoundError(err) {
+		t.Error("error is supposed to keep the behaviors of the original error")
+	}
+}
+
+func TestWithPublicMessage(t *testing.T) {
+	err := WithPublicMessage(fmt.Errorf("query user: %w", sql.ErrNoRows), "user not found")
+
+	message, ok := PublicMessage(err)
+	if !ok {
+		t.Fatal("error is supposed to carry a public message")
+	}
+
+	if want, have := "user not found", message; want != have {
+		t.Errorf("unexpected public message\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "query user: "+sql.ErrNoRows.Error(), err.Error(); want != have {
+		t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if WithPublicMessage(nil, "user not found") != nil {
+		t.Error("wrapping a nil error is supposed to return nil")
+	}
+}
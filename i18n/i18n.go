@@ -0,0 +1,146 @@
+// Package i18n provides tools for localizing messages returned to clients.
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Catalog resolves translations of messages.
+//
+// Messages are identified by their original (untranslated) text.
+type Catalog interface {
+	// Message returns the translation of a message for a locale (eg. "en-US").
+	Message(locale string, message string) (string, bool)
+}
+
+// MemoryCatalog is an in-memory Catalog.
+//
+// If there is no translation for a locale, MemoryCatalog falls back to its base language (eg. "en-US" to "en").
+type MemoryCatalog struct {
+	mu       sync.RWMutex
+	messages map[string]map[string]string
+}
+
+// NewMemoryCatalog returns a new MemoryCatalog.
+func NewMemoryCatalog() *MemoryCatalog {
+	return &MemoryCatalog{
+		messages: make(map[string]map[string]string),
+	}
+}
+
+// Set adds the translation of a message for a locale.
+func (c *MemoryCatalog) Set(locale string, message string, translation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	locale = strings.ToLower(locale)
+
+	if c.messages[locale] == nil {
+		c.messages[locale] = make(map[string]string)
+	}
+
+	c.messages[locale][message] = translation
+}
+
+// Message returns the translation of a message for a locale.
+func (c *MemoryCatalog) Message(locale string, message string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	locale = strings.ToLower(locale)
+
+	for {
+		if translation, ok := c.messages[locale][message]; ok {
+			return translation, true
+		}
+
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			return "", false
+		}
+
+		locale = locale[:i]
+	}
+}
+
+// ParseAcceptLanguage parses the value of an Accept-Language header
+// and returns the list of accepted locales ordered by preference.
+// The wildcard ("*") and locales with zero quality are omitted.
+func ParseAcceptLanguage(header string) []string {
+	type locale struct {
+		tag     string
+		quality float64
+	}
+
+	var locales []locale
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		locales = append(locales, locale{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(locales, func(i, j int) bool {
+		return locales[i].quality > locales[j].quality
+	})
+
+	tags := make([]string, 0, len(locales))
+
+	for _, l := range locales {
+		tags = append(tags, l.tag)
+	}
+
+	return tags
+}
+
+// Localizer translates messages to the first supported locale in a list of preferred locales.
+type Localizer struct {
+	catalog Catalog
+	locales []string
+}
+
+// NewLocalizer returns a new Localizer.
+func NewLocalizer(catalog Catalog, locales ...string) Localizer {
+	return Localizer{
+		catalog: catalog,
+		locales: locales,
+	}
+}
+
+// Localize returns the translation of a message and the locale of the translation.
+// If there is no translation in any of the preferred locales, the original message is returned with an empty locale.
+func (l Localizer) Localize(message string) (string, string) {
+	if l.catalog == nil || message == "" {
+		return message, ""
+	}
+
+	for _, locale := range l.locales {
+		if translation, ok := l.catalog.Message(locale, message); ok {
+			return translation, locale
+		}
+	}
+
+	return message, ""
+}
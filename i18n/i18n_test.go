@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemoryCatalog(t *testing.T) {
+	catalog := NewMemoryCatalog()
+	catalog.Set("hu", "not found", "nem található")
+	catalog.Set("de-AT", "not found", "nicht gefunden")
+
+	tests := []struct {
+		locale      string
+		translation string
+		ok          bool
+	}{
+		{
+			locale:      "hu",
+			translation: "nem található",
+			ok:          true,
+		},
+		{
+			locale:      "hu-HU",
+			translation: "nem található",
+			ok:          true,
+		},
+		{
+			locale:      "de-at",
+			translation: "nicht gefunden",
+			ok:          true,
+		},
+		{
+			locale: "de",
+		},
+		{
+			locale: "en",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.locale, func(t *testing.T) {
+			translation, ok := catalog.Message(test.locale, "not found")
+
+			if want, have := test.ok, ok; want != have {
+				t.Fatalf("unexpected result\nexpected: %t\nactual:   %t", want, have)
+			}
+
+			if want, have := test.translation, translation; want != have {
+				t.Errorf("unexpected translation\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header  string
+		locales []string
+	}{
+		{
+			header:  "",
+			locales: []string{},
+		},
+		{
+			header:  "hu",
+			locales: []string{"hu"},
+		},
+		{
+			header:  "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5",
+			locales: []string{"fr-CH", "fr", "en", "de"},
+		},
+		{
+			header:  "en;q=0.5, hu, de;q=0",
+			locales: []string{"hu", "en"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.header, func(t *testing.T) {
+			if want, have := test.locales, ParseAcceptLanguage(test.header); !reflect.DeepEqual(want, have) {
+				t.Errorf("unexpected locales\nexpected: %v\nactual:   %v", want, have)
+			}
+		})
+	}
+}
+
+func TestLocalizer(t *testing.T) {
+	catalog := NewMemoryCatalog()
+	catalog.Set("hu", "not found", "nem található")
+
+	localizer := NewLocalizer(catalog, "de", "hu")
+
+	message, locale := localizer.Localize("not found")
+
+	if want, have := "nem található", message; want != have {
+		t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "hu", locale; want != have {
+		t.Errorf("unexpected locale\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	message, locale = localizer.Localize("conflict")
+
+	if want, have := "conflict", message; want != have {
+		t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "", locale; want != have {
+		t.Errorf("unexpected locale\nexpected: %s\nactual:   %s", want, have)
+	}
+}
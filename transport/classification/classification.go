@@ -0,0 +1,91 @@
+// Package classification maps error behaviors to transport specific status codes.
+//
+// It serves as the single source of truth for the default error matchers of every transport,
+// keeping the classification of errors consistent across transports.
+package classification
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/sagikazarmark/appkit/errors"
+)
+
+// Names of the default error classes.
+const (
+	NotFound           = "not-found"
+	Validation         = "validation"
+	BadRequest         = "bad-request"
+	Conflict           = "conflict"
+	Unauthenticated    = "unauthenticated"
+	PermissionDenied   = "permission-denied"
+	TooManyRequests    = "too-many-requests"
+	Unavailable        = "unavailable"
+	Canceled           = "canceled"
+	DeadlineExceeded   = "deadline-exceeded"
+	Timeout            = "timeout"
+	PreconditionFailed = "precondition-failed"
+	AlreadyExists      = "already-exists"
+	NotImplemented     = "not-implemented"
+)
+
+// StatusClientClosedRequest is the (non-standard) HTTP status code of requests canceled by the client
+// (as introduced by nginx).
+const StatusClientClosedRequest = 499
+
+// Class describes a class of errors (usually errors with a certain behavior) and its representation in each transport.
+type Class struct {
+	// Name identifies the class (eg. "not-found").
+	Name string
+
+	// Matcher checks if an error belongs to the class.
+	Matcher func(err error) bool
+
+	// HTTPStatus is the HTTP status code of errors belonging to the class.
+	// Classes with a zero status are not mapped to HTTP.
+	HTTPStatus int
+
+	// GRPCCode is the gRPC status code of errors belonging to the class.
+	// Classes with an OK code are not mapped to gRPC.
+	GRPCCode codes.Code
+}
+
+// DefaultClasses is the list of default error classes.
+//
+// Classes are evaluated in order and the first matching one wins,
+// which defines the precedence for errors with multiple behaviors (eg. joined errors).
+// In particular, NotFound takes precedence over Validation:
+// reporting violations for a resource that does not exist is pointless.
+//
+// Context errors (context.Canceled and context.DeadlineExceeded) are classified separately,
+// so that transports can opt out of treating them specially.
+// nolint: gochecknoglobals
+var DefaultClasses = []Class{
+	{NotFound, errors.IsNotFoundError, http.StatusNotFound, codes.NotFound},
+	{Validation, errors.IsValidationError, http.StatusUnprocessableEntity, codes.InvalidArgument},
+	{BadRequest, errors.IsBadRequestError, http.StatusBadRequest, codes.InvalidArgument},
+	{Conflict, errors.IsConflictError, http.StatusConflict, codes.FailedPrecondition},
+	{Unauthenticated, errors.IsUnauthenticatedError, http.StatusUnauthorized, codes.Unauthenticated},
+	{PermissionDenied, errors.IsPermissionDeniedError, http.StatusForbidden, codes.PermissionDenied},
+	{TooManyRequests, errors.IsTooManyRequestsError, http.StatusTooManyRequests, codes.ResourceExhausted},
+	{Unavailable, errors.IsUnavailableError, http.StatusServiceUnavailable, codes.Unavailable},
+	{Canceled, errors.MatchIs(context.Canceled), StatusClientClosedRequest, codes.Canceled},
+	{DeadlineExceeded, errors.MatchIs(context.DeadlineExceeded), http.StatusGatewayTimeout, codes.DeadlineExceeded},
+	{Timeout, errors.IsTimeoutError, http.StatusGatewayTimeout, codes.DeadlineExceeded},
+	{PreconditionFailed, errors.IsPreconditionFailedError, http.StatusPreconditionFailed, codes.FailedPrecondition},
+	{AlreadyExists, errors.IsAlreadyExistsError, http.StatusConflict, codes.AlreadyExists},
+	{NotImplemented, errors.IsNotImplementedError, http.StatusNotImplemented, codes.Unimplemented},
+}
+
+// Classify returns the first class in a list of classes matching an error.
+func Classify(classes []Class, err error) (Class, bool) {
+	for _, class := range classes {
+		if class.Matcher != nil && class.Matcher(err) {
+			return class, true
+		}
+	}
+
+	return Class{}, false
+}
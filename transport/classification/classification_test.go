@@ -0,0 +1,109 @@
+package classification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func TestDefaultClasses(t *testing.T) {
+	names := make(map[string]bool, len(DefaultClasses))
+
+	for _, class := range DefaultClasses {
+		if names[class.Name] {
+			t.Errorf("duplicate class: %s", class.Name)
+		}
+
+		names[class.Name] = true
+
+		if class.Matcher == nil {
+			t.Errorf("class %s is supposed to have a matcher", class.Name)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		err        error
+		name       string
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{
+			err:        appkiterrors.NewNotFound("user", 1),
+			name:       NotFound,
+			httpStatus: http.StatusNotFound,
+			grpcCode:   codes.NotFound,
+		},
+		{
+			err:        appkiterrors.NewValidation("invalid user", nil),
+			name:       Validation,
+			httpStatus: http.StatusUnprocessableEntity,
+			grpcCode:   codes.InvalidArgument,
+		},
+		{
+			err:        errors.Join(appkiterrors.NewValidation("invalid user", nil), appkiterrors.NewNotFound("user", 1)),
+			name:       NotFound,
+			httpStatus: http.StatusNotFound,
+			grpcCode:   codes.NotFound,
+		},
+		{
+			err:        context.Canceled,
+			name:       Canceled,
+			httpStatus: StatusClientClosedRequest,
+			grpcCode:   codes.Canceled,
+		},
+		{
+			err:        context.DeadlineExceeded,
+			name:       DeadlineExceeded,
+			httpStatus: http.StatusGatewayTimeout,
+			grpcCode:   codes.DeadlineExceeded,
+		},
+		{
+			err:        appkiterrors.NewTimeout("timeout"),
+			name:       Timeout,
+			httpStatus: http.StatusGatewayTimeout,
+			grpcCode:   codes.DeadlineExceeded,
+		},
+		{
+			err:        appkiterrors.NewAlreadyExists("user", 1),
+			name:       AlreadyExists,
+			httpStatus: http.StatusConflict,
+			grpcCode:   codes.AlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			class, ok := Classify(DefaultClasses, test.err)
+			if !ok {
+				t.Fatal("error is supposed to be classified")
+			}
+
+			if want, have := test.name, class.Name; want != have {
+				t.Errorf("unexpected class\nexpected: %s\nactual:   %s", want, have)
+			}
+
+			if want, have := test.httpStatus, class.HTTPStatus; want != have {
+				t.Errorf("unexpected HTTP status\nexpected: %d\nactual:   %d", want, have)
+			}
+
+			if want, have := test.grpcCode, class.GRPCCode; want != have {
+				t.Errorf("unexpected gRPC code\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+
+	t.Run("unclassified", func(t *testing.T) {
+		if _, ok := Classify(DefaultClasses, errors.New("error")); ok {
+			t.Error("error is NOT supposed to be classified")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDExtractor extracts a request ID (eg. correlation ID) from the context.
+// It returns an empty string if the context does not contain a request ID.
+type RequestIDExtractor func(ctx context.Context) string
+
+// MetadataRequestIDExtractor returns a RequestIDExtractor that reads the request ID
+// from the incoming metadata stored in the context.
+func MetadataRequestIDExtractor(key string) RequestIDExtractor {
+	return func(ctx context.Context) string {
+		if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+			return values[0]
+		}
+
+		return ""
+	}
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+
+	invoker := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		return status.Error(codes.NotFound, "not found")
+	}
+
+	err := interceptor(context.Background(), "/service/Method", nil, nil, nil, invoker)
+
+	if !appkiterrors.IsNotFoundError(err) {
+		t.Error("error is supposed to be a NotFound error")
+	}
+}
+
+type clientStreamStub struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (s clientStreamStub) RecvMsg(_ interface{}) error {
+	return s.err
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	interceptor := StreamClientInterceptor()
+
+	t.Run("status", func(t *testing.T) {
+		streamer := func(_ context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			return clientStreamStub{err: status.Error(codes.NotFound, "not found")}, nil
+		}
+
+		stream, err := interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/service/Method", streamer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := stream.RecvMsg(nil); !appkiterrors.IsNotFoundError(err) {
+			t.Error("error is supposed to be a NotFound error")
+		}
+	})
+
+	t.Run("eof", func(t *testing.T) {
+		streamer := func(_ context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			return clientStreamStub{err: io.EOF}, nil
+		}
+
+		stream, err := interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/service/Method", streamer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := stream.RecvMsg(nil); err != io.EOF { // nolint: errorlint
+			t.Error("io.EOF is supposed to be returned unchanged")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorHandler receives errors returned by handlers before they are converted to a status.
+// It can be used to log or record the original error before it gets masked by the conversion.
+// It receives every error, including the ones already carrying a status (eg. returned by downstream services).
+//
+// ErrorHandler is compatible with go-kit's transport.ErrorHandler.
+type ErrorHandler interface {
+	Handle(ctx context.Context, err error)
+}
+
+type serverInterceptor struct {
+	converter    StatusConverter
+	errorHandler ErrorHandler
+
+	convertStatusErrors bool
+}
+
+// ServerInterceptorOption configures a server interceptor using the functional options paradigm
+// popularized by Rob Pike and Dave Cheney.
+// If you're unfamiliar with this style, see:
+// - https://commandcenter.blogspot.com/2014/01/self-referential-functions-and-design.html
+// - https://dave.cheney.net/2014/10/17/functional-options-for-friendly-apis.
+type ServerInterceptorOption interface {
+	apply(i *serverInterceptor)
+}
+
+type serverInterceptorOptionFunc func(*serverInterceptor)
+
+func (f serverInterceptorOptionFunc) apply(i *serverInterceptor) { f(i) }
+
+// WithErrorHandler configures a server interceptor to pass errors to an ErrorHandler
+// before converting them to a status.
+func WithErrorHandler(handler ErrorHandler) ServerInterceptorOption {
+	return serverInterceptorOptionFunc(func(i *serverInterceptor) {
+		i.errorHandler = handler
+	})
+}
+
+// WithStatusErrorConversion configures a server interceptor to convert errors that already carry a gRPC status
+// using the StatusConverter as well (instead of returning them unchanged).
+//
+// Combine it with NewStatusPassthroughMatcher to control which statuses propagate
+// (and how their codes are remapped).
+func WithStatusErrorConversion() ServerInterceptorOption {
+	return serverInterceptorOptionFunc(func(i *serverInterceptor) {
+		i.convertStatusErrors = true
+	})
+}
+
+func newServerInterceptor(converter StatusConverter, opts []ServerInterceptorOption) serverInterceptor {
+	i := serverInterceptor{
+		converter: converter,
+	}
+
+	for _, opt := range opts {
+		opt.apply(&i)
+	}
+
+	return i
+}
+
+// convertError converts an error to a gRPC status error.
+// Errors that already carry a gRPC status are returned unchanged (unless configured otherwise).
+func (i serverInterceptor) convertError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if i.errorHandler != nil {
+		i.errorHandler.Handle(ctx, err)
+	}
+
+	var serr interface {
+		GRPCStatus() *status.Status
+	}
+
+	if !i.convertStatusErrors && errors.As(err, &serr) {
+		return err
+	}
+
+	return i.converter.NewStatus(ctx, err).Err()
+}
+
+// UnaryServerInterceptor returns a server interceptor that converts errors returned by unary handlers
+// to a gRPC status using a StatusConverter.
+// Errors that already carry a gRPC status are returned unchanged (see WithStatusErrorConversion).
+func UnaryServerInterceptor(converter StatusConverter, opts ...ServerInterceptorOption) grpc.UnaryServerInterceptor {
+	i := newServerInterceptor(converter, opts)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, i.convertError(ctx, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns a server interceptor that converts errors returned by stream handlers
+// to a gRPC status using a StatusConverter.
+// Errors that already carry a gRPC status are returned unchanged (see WithStatusErrorConversion).
+func StreamServerInterceptor(converter StatusConverter, opts ...ServerInterceptorOption) grpc.StreamServerInterceptor {
+	i := newServerInterceptor(converter, opts)
+
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return i.convertError(stream.Context(), handler(srv, stream))
+	}
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type errorHandlerStub struct {
+	errs []error
+}
+
+func (h *errorHandlerStub) Handle(_ context.Context, err error) {
+	h.errs = append(h.errs, err)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Run("convert", func(t *testing.T) {
+		origErr := errors.New("error")
+		errorHandler := &errorHandlerStub{}
+
+		interceptor := UnaryServerInterceptor(NewDefaultStatusConverter(), WithErrorHandler(errorHandler))
+
+		handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, origErr
+		}
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		st, ok := status.FromError(err)
+		if !ok {
+			t.Fatal("error is supposed to carry a status")
+		}
+
+		testStatusEquals(t, st, codes.Internal, "something went wrong")
+
+		if len(errorHandler.errs) != 1 || errorHandler.errs[0] != origErr { // nolint: errorlint
+			t.Error("error handler is supposed to receive the original error")
+		}
+	})
+
+	t.Run("match", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(NewDefaultStatusConverter())
+
+		handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, notFoundStub{}
+		}
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		testStatusEquals(t, status.Convert(err), codes.NotFound, "not found")
+	})
+
+	t.Run("status", func(t *testing.T) {
+		errorHandler := &errorHandlerStub{}
+
+		interceptor := UnaryServerInterceptor(NewDefaultStatusConverter(), WithErrorHandler(errorHandler))
+
+		handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		testStatusEquals(t, status.Convert(err), codes.Unavailable, "unavailable")
+
+		if len(errorHandler.errs) != 1 || errorHandler.errs[0] != err { // nolint: errorlint
+			t.Error("error handler is supposed to receive errors carrying a status")
+		}
+	})
+
+	t.Run("status_conversion", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(WithStatusMatchers(NewStatusPassthroughMatcher(
+			WithPassthroughCodeMapping(codes.NotFound, codes.Internal),
+		)))
+
+		interceptor := UnaryServerInterceptor(converter, WithStatusErrorConversion())
+
+		tests := []struct {
+			err     error
+			code    codes.Code
+			message string
+		}{
+			{
+				err:     status.Error(codes.NotFound, "user not found"),
+				code:    codes.Internal,
+				message: "user not found",
+			},
+			{
+				err:     status.Error(codes.Unavailable, "unavailable"),
+				code:    codes.Unavailable,
+				message: "unavailable",
+			},
+		}
+
+		for _, test := range tests {
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, test.err
+			}
+
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+			testStatusEquals(t, status.Convert(err), test.code, test.message)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(NewDefaultStatusConverter())
+
+		handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return "response", nil
+		}
+
+		resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want, have := "response", resp; want != have {
+			t.Errorf("unexpected response\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+}
+
+type serverStreamStub struct {
+	grpc.ServerStream
+}
+
+func (serverStreamStub) Context() context.Context {
+	return context.Background()
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor(NewDefaultStatusConverter())
+
+	handler := func(_ interface{}, _ grpc.ServerStream) error {
+		return notFoundStub{}
+	}
+
+	err := interceptor(nil, serverStreamStub{}, &grpc.StreamServerInfo{}, handler)
+
+	testStatusEquals(t, status.Convert(err), codes.NotFound, "not found")
+}
+
+func TestStreamServerInterceptor_ErrorHandler(t *testing.T) {
+	errorHandler := &errorHandlerStub{}
+
+	interceptor := StreamServerInterceptor(NewDefaultStatusConverter(), WithErrorHandler(errorHandler))
+
+	origErr := fmt.Errorf("calling downstream service: %w", status.Error(codes.Unavailable, "unavailable"))
+
+	handler := func(_ interface{}, _ grpc.ServerStream) error {
+		return origErr
+	}
+
+	err := interceptor(nil, serverStreamStub{}, &grpc.StreamServerInfo{}, handler)
+
+	if err != origErr { // nolint: errorlint
+		t.Error("error carrying a status is supposed to be returned unchanged")
+	}
+
+	if len(errorHandler.errs) != 1 || errorHandler.errs[0] != origErr { // nolint: errorlint
+		t.Error("error handler is supposed to receive errors carrying a status")
+	}
+}
@@ -1,15 +1,86 @@
 package grpc
 
 import (
+	"context"
+
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	"github.com/sagikazarmark/appkit/errors"
+	"github.com/sagikazarmark/appkit/transport/classification"
 )
 
-// DefaultStatusMatchers is a list of default StatusMatchers.
+// DefaultStatusMatchers is a list of default StatusMatchers derived from classification.DefaultClasses.
+//
+// Matchers are evaluated in order and the first matching one wins,
+// which defines the precedence for errors with multiple behaviors (eg. joined errors).
 // nolint: gochecknoglobals
-var DefaultStatusMatchers = []StatusMatcher{
-	NewStatusCodeMatcher(codes.NotFound, errors.IsNotFoundError),
-	NewValidationStatusMatcher(),
-	NewStatusCodeMatcher(codes.FailedPrecondition, errors.IsConflictError),
+var DefaultStatusMatchers = NewStatusMatchers(classification.DefaultClasses)
+
+// NewStatusMatchers returns a list of StatusMatchers derived from a list of error classes (preserving their order).
+// Classes not mapped to a gRPC code are skipped.
+//
+// Statuses of Validation and BadRequest classes carry violation info (see NewValidationStatusMatcher)
+// and statuses of the TooManyRequests class carry retry and quota failure info (see NewTooManyRequestsStatusMatcher).
+// Just like any other class, they are matched using the matcher and the code of the class.
+//
+// Matchers derived from context error classes (see classification.Canceled and classification.DeadlineExceeded)
+// can be excluded from a StatusConverter using WithoutContextErrors.
+func NewStatusMatchers(classes []classification.Class) []StatusMatcher {
+	matchers := make([]StatusMatcher, 0, len(classes))
+
+	for _, class := range classes {
+		if class.GRPCCode == codes.OK {
+			continue
+		}
+
+		switch class.Name {
+		case classification.Validation, classification.BadRequest:
+			matchers = append(matchers, newViolationStatusMatcher(class.Matcher, class.GRPCCode))
+
+		case classification.TooManyRequests:
+			matchers = append(matchers, newTooManyRequestsStatusMatcher(class.Matcher, class.GRPCCode))
+
+		case classification.Canceled, classification.DeadlineExceeded:
+			matchers = append(matchers, contextErrorStatusMatcher{
+				StatusCodeMatcher: NewStatusCodeMatcher(class.GRPCCode, class.Matcher),
+				message:           contextErrorMessage(class.Name),
+			})
+
+		default:
+			matchers = append(matchers, NewStatusCodeMatcher(class.GRPCCode, class.Matcher))
+		}
+	}
+
+	return matchers
+}
+
+// contextErrorStatusMatcher marks status matchers of context errors (see WithoutContextErrors).
+//
+// Context errors are often wrapped by errors carrying internal details (eg. *url.Error containing the URL of a request),
+// so statuses are created with a fixed message.
+type contextErrorStatusMatcher struct {
+	StatusCodeMatcher
+
+	message string
+}
+
+func (m contextErrorStatusMatcher) NewStatus(_ context.Context, _ error) *status.Status {
+	return status.New(m.Code(), m.message)
+}
+
+func (contextErrorStatusMatcher) unredacted() {}
+
+// unredactedStatusMatcher is implemented by status matchers creating statuses with a message
+// that is safe to expose as is, so it is not redacted (see WithRedaction).
+type unredactedStatusMatcher interface {
+	unredacted()
+}
+
+// contextErrorMessage returns the fixed message of a context error class.
+func contextErrorMessage(name string) string {
+	if name == classification.Canceled {
+		return "request canceled"
+	}
+
+	return "deadline exceeded"
 }
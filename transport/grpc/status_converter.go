@@ -3,9 +3,16 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+	"github.com/sagikazarmark/appkit/i18n"
 )
 
 // StatusConverter converts an error to gRPC Status.
@@ -38,6 +45,9 @@ type statusMatcher struct {
 }
 
 // ErrorMatcher checks if an error matches a certain condition.
+//
+// See errors.MatchAny, errors.MatchAll, errors.Not, errors.MatchIs, errors.MatchAs, errors.MatchMessage
+// and errors.MatchRegexp for building matchers.
 type ErrorMatcher func(err error) bool
 
 // NewStatusCodeMatcher returns a new StatusCodeMatcher.
@@ -77,6 +87,19 @@ type statusConverter struct {
 
 	statusConverter     StatusConverter
 	statusCodeConverter StatusCodeConverter
+
+	errorInfoDomain string
+
+	requestInfo bool
+	requestID   RequestIDExtractor
+
+	catalog i18n.Catalog
+
+	redactor appkiterrors.Redactor
+
+	debug bool
+
+	withoutContextErrors bool
 }
 
 // StatusConverterOption configures a StatusConverter using the functional options paradigm
@@ -136,6 +159,77 @@ func WithStatusCodeConverter(converter StatusCodeConverter) StatusConverterOptio
 	})
 }
 
+// WithErrorInfoDomain configures the domain of the ErrorInfo detail attached to statuses.
+// The domain is typically the name of the service (eg. "myservice.example.com").
+//
+// See errors.ErrorCode and errors.Details for details about the information carried by ErrorInfo.
+func WithErrorInfoDomain(domain string) StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.errorInfoDomain = domain
+	})
+}
+
+// WithRequestInfo configures a StatusConverter to attach a RequestInfo detail to statuses
+// with a request ID (eg. correlation ID) extracted from the context and the name of the called method,
+// so that a status reported by a client can be tied to server logs.
+//
+// If requestID is nil, only the name of the called method is attached.
+func WithRequestInfo(requestID RequestIDExtractor) StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.requestInfo = true
+		c.requestID = requestID
+	})
+}
+
+// WithLocalization configures a StatusConverter to attach a LocalizedMessage detail to statuses
+// (and to field violations of BadRequest details) translated using a message catalog.
+// The locale is resolved from the "accept-language" key of the incoming metadata.
+func WithLocalization(catalog i18n.Catalog) StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.catalog = catalog
+	})
+}
+
+// WithRedaction configures a StatusConverter to compute the message of statuses created for matched errors
+// using a Redactor instead of exposing the message of the error verbatim
+// (which may leak internal context carried by wrapped causes).
+//
+// For example:
+//   - errors.OuterMessage exposes the message of the outermost error only
+//   - errors.PublicMessageRedactor exposes messages explicitly marked as public
+//   - errors.FullMessage exposes the full chain of messages (useful in non-production environments)
+//
+// The fixed messages of statuses created for context errors
+// and the messages of statuses propagated by NewStatusPassthroughMatcher are not redacted.
+func WithRedaction(redactor appkiterrors.Redactor) StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.redactor = redactor
+	})
+}
+
+// WithDebug configures a StatusConverter to attach a DebugInfo detail to statuses
+// containing the full Unwrap chain of the error (including the branches of joined errors)
+// and its stack trace (if any).
+//
+// Debug information is attached to every status (including the ones created for unmatched errors).
+// It may contain sensitive information, so it should only be enabled in non-production environments.
+//
+// See errors.Chain and errors.StackTrace for details.
+func WithDebug() StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.debug = true
+	})
+}
+
+// WithoutContextErrors configures a StatusConverter to exclude the default matchers of context errors
+// (context.Canceled and context.DeadlineExceeded), so that they are converted to statuses with Internal code
+// (unless another matcher matches them).
+func WithoutContextErrors() StatusConverterOption {
+	return statusConverterOptionFunc(func(c *statusConverter) {
+		c.withoutContextErrors = true
+	})
+}
+
 // NewStatusConverter returns a new StatusConverter implementation.
 func NewStatusConverter(opts ...StatusConverterOption) StatusConverter {
 	c := statusConverter{}
@@ -144,6 +238,18 @@ func NewStatusConverter(opts ...StatusConverterOption) StatusConverter {
 		opt.apply(&c)
 	}
 
+	if c.withoutContextErrors {
+		matchers := make([]StatusMatcher, 0, len(c.matchers))
+
+		for _, matcher := range c.matchers {
+			if _, ok := matcher.(contextErrorStatusMatcher); !ok {
+				matchers = append(matchers, matcher)
+			}
+		}
+
+		c.matchers = matchers
+	}
+
 	if c.statusConverter == nil {
 		c.statusConverter = defaultStatusConverter{}
 	}
@@ -160,17 +266,37 @@ func NewStatusConverter(opts ...StatusConverterOption) StatusConverter {
 }
 
 func (c statusConverter) NewStatus(ctx context.Context, err error) *status.Status {
-	for _, matcher := range c.matchers {
-		if matcher.MatchError(err) {
-			if converter, ok := matcher.(StatusConverter); ok {
-				return converter.NewStatus(ctx, err)
-			}
+	st := c.matchStatus(ctx, err)
 
-			if statusMatcher, ok := matcher.(StatusCodeMatcher); ok {
-				return c.statusCodeConverter.NewStatusWithCode(ctx, statusMatcher.Code(), err)
-			}
+	if c.catalog != nil {
+		st = localizeStatus(st, statusLocalizer(ctx, c.catalog))
+	}
+
+	if c.debug {
+		st = withDetails(st, newDebugInfo(err))
+	}
+
+	if c.requestInfo {
+		requestInfo := &errdetails.RequestInfo{}
+
+		if c.requestID != nil {
+			requestInfo.RequestId = c.requestID(ctx)
+		}
+
+		if method, ok := grpc.Method(ctx); ok {
+			requestInfo.ServingData = method
+		}
+
+		st = withDetails(st, requestInfo)
+	}
+
+	return st
+}
 
-			return c.statusConverter.NewStatus(ctx, err)
+func (c statusConverter) matchStatus(ctx context.Context, err error) *status.Status {
+	for _, matcher := range c.matchers {
+		if matcher.MatchError(err) {
+			return c.decorateStatus(ctx, matcher, err, c.newStatus(ctx, matcher, err))
 		}
 	}
 
@@ -181,7 +307,81 @@ func (c statusConverter) NewStatus(ctx context.Context, err error) *status.Statu
 	)
 }
 
+func (c statusConverter) newStatus(ctx context.Context, matcher StatusMatcher, err error) *status.Status {
+	if converter, ok := matcher.(StatusConverter); ok {
+		return converter.NewStatus(ctx, err)
+	}
+
+	if statusMatcher, ok := matcher.(StatusCodeMatcher); ok {
+		return c.statusCodeConverter.NewStatusWithCode(ctx, statusMatcher.Code(), err)
+	}
+
+	return c.statusConverter.NewStatus(ctx, err)
+}
+
+// decorateStatus adds information carried by a matched error to the status.
+func (c statusConverter) decorateStatus(_ context.Context, matcher StatusMatcher, err error, st *status.Status) *status.Status {
+	if _, ok := matcher.(unredactedStatusMatcher); c.redactor != nil && !ok {
+		proto := st.Proto()
+		proto.Message = c.redactor(err)
+
+		st = status.FromProto(proto)
+	}
+
+	var details []protoadapt.MessageV1
+
+	code, hasCode := appkiterrors.ErrorCode(err)
+	metadata, hasMetadata := appkiterrors.Details(err)
+
+	if (hasCode || hasMetadata) && !hasErrorInfo(st) {
+		errorInfo := &errdetails.ErrorInfo{
+			Reason: code,
+			Domain: c.errorInfoDomain,
+		}
+
+		if hasMetadata {
+			errorInfo.Metadata = make(map[string]string, len(metadata))
+
+			for key, value := range metadata {
+				errorInfo.Metadata[key] = fmt.Sprint(value)
+			}
+		}
+
+		details = append(details, errorInfo)
+	}
+
+	return withDetails(st, details...)
+}
+
+// hasErrorInfo checks if a status already carries an ErrorInfo detail (eg. a status passed through from a downstream service).
+func hasErrorInfo(st *status.Status) bool {
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.ErrorInfo); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // NewStatusConverter returns a new StatusConverter implementation populated with default status matchers.
 func NewDefaultStatusConverter(opts ...StatusConverterOption) StatusConverter {
 	return NewStatusConverter(append(opts, WithStatusMatchers(DefaultStatusMatchers...))...)
 }
+
+// withDetails attaches details to a status.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	if len(details) == 0 {
+		return st
+	}
+
+	st, err := st.WithDetails(details...)
+	if err != nil {
+		// If this errored, it will always error
+		// here, so better panic so we can figure
+		// out why than have this silently passing.
+		panic(fmt.Errorf("unexpected error attaching metadata: %w", err))
+	}
+
+	return st
+}
@@ -5,10 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
 )
 
 func TestNewStatusCodeMatcher(t *testing.T) {
@@ -23,6 +28,21 @@ func TestNewStatusCodeMatcher(t *testing.T) {
 	}
 }
 
+func TestNewStatusCodeMatcher_Combinators(t *testing.T) {
+	matcher := NewStatusCodeMatcher(
+		codes.DeadlineExceeded,
+		appkiterrors.MatchAny(appkiterrors.MatchIs(context.DeadlineExceeded), appkiterrors.IsTimeoutError),
+	)
+
+	if !matcher.MatchError(fmt.Errorf("call: %w", context.DeadlineExceeded)) {
+		t.Error("error is supposed to be matched")
+	}
+
+	if matcher.MatchError(context.Canceled) {
+		t.Error("error is NOT supposed to be matched")
+	}
+}
+
 type matcherStub struct {
 	err error
 }
@@ -192,3 +212,300 @@ func ExampleNewStatusConverter() {
 
 	// Output: NotFound not found
 }
+
+type errorCodeStub struct{}
+
+func (errorCodeStub) Error() string {
+	return "error"
+}
+
+func (errorCodeStub) ErrorCode() string {
+	return "USER_NOT_FOUND"
+}
+
+func TestStatusConverter_ErrorCode(t *testing.T) {
+	err := errorCodeStub{}
+
+	t.Run("matched", func(t *testing.T) {
+		statusConverter := NewStatusConverter(
+			WithStatusMatchers(statusMatcherStub{
+				err:  err,
+				code: codes.NotFound,
+			}),
+			WithErrorInfoDomain("example.com"),
+		)
+
+		s := statusConverter.NewStatus(context.Background(), err)
+
+		testStatusEquals(t, s, codes.NotFound, "error")
+
+		errorInfo, ok := s.Details()[0].(*errdetails.ErrorInfo)
+		if !ok {
+			t.Fatal("status is expected to contain error info")
+		}
+
+		if want, have := "USER_NOT_FOUND", errorInfo.GetReason(); want != have {
+			t.Errorf("unexpected reason\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := "example.com", errorInfo.GetDomain(); want != have {
+			t.Errorf("unexpected domain\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		statusConverter := NewStatusConverter()
+
+		s := statusConverter.NewStatus(context.Background(), err)
+
+		testStatusEquals(t, s, codes.Internal, "something went wrong")
+
+		if len(s.Details()) > 0 {
+			t.Error("status is NOT expected to contain details")
+		}
+	})
+}
+
+type detailsStub struct{}
+
+func (detailsStub) Error() string {
+	return "error"
+}
+
+func (detailsStub) Details() map[string]interface{} {
+	return map[string]interface{}{
+		"id": 1,
+	}
+}
+
+func TestStatusConverter_Details(t *testing.T) {
+	err := detailsStub{}
+
+	statusConverter := NewStatusConverter(
+		WithStatusMatchers(statusMatcherStub{
+			err:  err,
+			code: codes.NotFound,
+		}),
+	)
+
+	s := statusConverter.NewStatus(context.Background(), err)
+
+	errorInfo, ok := s.Details()[0].(*errdetails.ErrorInfo)
+	if !ok {
+		t.Fatal("status is expected to contain error info")
+	}
+
+	if want, have := "1", errorInfo.GetMetadata()["id"]; want != have {
+		t.Errorf("unexpected metadata\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+type badRequestWithViolationsStub struct{}
+
+func (badRequestWithViolationsStub) Error() string {
+	return "bad request"
+}
+
+func (badRequestWithViolationsStub) BadRequest() bool {
+	return true
+}
+
+func (badRequestWithViolationsStub) Violations() map[string][]string {
+	return map[string][]string{"page_size": {"must be positive"}}
+}
+
+func TestStatusConverter_BadRequest(t *testing.T) {
+	statusConverter := NewDefaultStatusConverter()
+
+	t.Run("without_violations", func(t *testing.T) {
+		st := statusConverter.NewStatus(context.Background(), appkiterrors.NewBadRequest("bad request"))
+
+		testStatusEquals(t, st, codes.InvalidArgument, "bad request")
+
+		if want, have := 0, len(st.Details()); want != have {
+			t.Errorf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+
+	t.Run("with_violations", func(t *testing.T) {
+		st := statusConverter.NewStatus(context.Background(), badRequestWithViolationsStub{})
+
+		testStatusEquals(t, st, codes.InvalidArgument, "bad request")
+
+		br, ok := st.Details()[0].(*errdetails.BadRequest)
+		if !ok {
+			t.Fatal("status is expected to contain a bad request detail")
+		}
+
+		violation := br.GetFieldViolations()[0]
+
+		if want, have := "page_size", violation.GetField(); want != have {
+			t.Errorf("unexpected field\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := "must be positive", violation.GetDescription(); want != have {
+			t.Errorf("unexpected description\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+}
+
+func TestStatusConverter_ContextErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []StatusConverterOption
+		err     error
+		code    codes.Code
+	}{
+		{
+			name: "Canceled",
+			err:  fmt.Errorf("call: %w", context.Canceled),
+			code: codes.Canceled,
+		},
+		{
+			name: "DeadlineExceeded",
+			err:  fmt.Errorf("call: %w", context.DeadlineExceeded),
+			code: codes.DeadlineExceeded,
+		},
+		{
+			name:    "Canceled/Excluded",
+			options: []StatusConverterOption{WithoutContextErrors()},
+			err:     context.Canceled,
+			code:    codes.Internal,
+		},
+		{
+			name:    "DeadlineExceeded/Excluded",
+			options: []StatusConverterOption{WithoutContextErrors()},
+			err:     context.DeadlineExceeded,
+			code:    codes.Internal,
+		},
+		{
+			name:    "Timeout/Excluded",
+			options: []StatusConverterOption{WithoutContextErrors()},
+			err:     appkiterrors.NewTimeout("timeout"),
+			code:    codes.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			st := NewDefaultStatusConverter(test.options...).NewStatus(context.Background(), test.err)
+
+			if want, have := test.code, st.Code(); want != have {
+				t.Errorf("unexpected code\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestStatusConverter_ContextErrors_Masked(t *testing.T) {
+	tests := []struct {
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{
+			err:     &url.Error{Op: "Get", URL: "http://billing.internal:8080/secret", Err: context.Canceled},
+			code:    codes.Canceled,
+			message: "request canceled",
+		},
+		{
+			err:     fmt.Errorf("call billing: %w", &url.Error{Op: "Get", URL: "http://billing.internal:8080/secret", Err: context.DeadlineExceeded}),
+			code:    codes.DeadlineExceeded,
+			message: "deadline exceeded",
+		},
+	}
+
+	converters := map[string]StatusConverter{
+		"default":   NewDefaultStatusConverter(),
+		"redaction": NewDefaultStatusConverter(WithRedaction(appkiterrors.OuterMessage)),
+	}
+
+	for name, converter := range converters {
+		converter := converter
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				testStatusEquals(t, converter.NewStatus(context.Background(), test.err), test.code, test.message)
+			})
+		}
+	}
+}
+
+func TestStatusConverter_Redaction(t *testing.T) {
+	err := appkiterrors.WithNotFound(fmt.Errorf("user not found: %w", errors.New("select * from users: connection refused")))
+
+	tests := []struct {
+		name     string
+		options  []StatusConverterOption
+		err      error
+		expected string
+	}{
+		{
+			name:     "Disabled",
+			err:      err,
+			expected: err.Error(),
+		},
+		{
+			name:     "OuterMessage",
+			options:  []StatusConverterOption{WithRedaction(appkiterrors.OuterMessage)},
+			err:      err,
+			expected: "user not found",
+		},
+		{
+			name:     "PublicMessage",
+			options:  []StatusConverterOption{WithRedaction(appkiterrors.PublicMessageRedactor(nil))},
+			err:      appkiterrors.WithPublicMessage(err, "the user does not exist"),
+			expected: "the user does not exist",
+		},
+		{
+			name:     "FullMessage",
+			options:  []StatusConverterOption{WithRedaction(appkiterrors.FullMessage)},
+			err:      err,
+			expected: err.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			st := NewDefaultStatusConverter(test.options...).NewStatus(context.Background(), test.err)
+
+			testStatusEquals(t, st, codes.NotFound, test.expected)
+		})
+	}
+}
+
+func TestStatusConverter_RequestInfo(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "1234"))
+
+	tests := []error{
+		notFoundStub{},
+		errors.New("error"),
+	}
+
+	for _, err := range tests {
+		err := err
+
+		t.Run("", func(t *testing.T) {
+			statusConverter := NewDefaultStatusConverter(WithRequestInfo(MetadataRequestIDExtractor("x-request-id")))
+
+			s := statusConverter.NewStatus(ctx, err)
+
+			details := s.Details()
+
+			requestInfo, ok := details[len(details)-1].(*errdetails.RequestInfo)
+			if !ok {
+				t.Fatal("status is expected to contain request info")
+			}
+
+			if want, have := "1234", requestInfo.GetRequestId(); want != have {
+				t.Errorf("unexpected request ID\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// newDebugInfo returns a DebugInfo detail describing the Unwrap chain (one error per line) and the stack trace of an error.
+func newDebugInfo(err error) *errdetails.DebugInfo {
+	chain := appkiterrors.Chain(err)
+
+	lines := make([]string, 0, len(chain))
+	for _, e := range chain {
+		lines = append(lines, fmt.Sprintf("%T: %s", e, e.Error()))
+	}
+
+	stackTrace, _ := appkiterrors.StackTrace(err)
+
+	return &errdetails.DebugInfo{
+		StackEntries: stackTrace,
+		Detail:       strings.Join(lines, "\n"),
+	}
+}
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+type stackTraceStub struct{}
+
+func (stackTraceStub) Error() string {
+	return "error"
+}
+
+func (stackTraceStub) StackTrace() []string {
+	return []string{"main.main", "runtime.main"}
+}
+
+func TestWithDebug(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", errors.Join(errors.New("first"), stackTraceStub{}))
+
+	t.Run("enabled", func(t *testing.T) {
+		st := NewDefaultStatusConverter(WithDebug()).NewStatus(context.Background(), err)
+
+		debugInfo, ok := st.Details()[0].(*errdetails.DebugInfo)
+		if !ok {
+			t.Fatal("status is expected to contain debug info")
+		}
+
+		wantDetail := "*fmt.wrapError: wrapped: first\nerror\n" +
+			"*errors.joinError: first\nerror\n" +
+			"*errors.errorString: first\n" +
+			"grpc.stackTraceStub: error"
+
+		if want, have := wantDetail, debugInfo.GetDetail(); want != have {
+			t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := []string{"main.main", "runtime.main"}, debugInfo.GetStackEntries(); !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected stack entries\nexpected: %v\nactual:   %v", want, have)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		st := NewDefaultStatusConverter().NewStatus(context.Background(), err)
+
+		if want, have := 0, len(st.Details()); want != have {
+			t.Errorf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package grpc
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// StatusError is an error decoded from a gRPC status.
+//
+// StatusError implements the error behaviors from the errors package based on the code and the details of the status,
+// so that errors returned by remote services can be inspected the same way as local ones
+// (eg. errors.IsNotFoundError).
+type StatusError struct {
+	status *status.Status
+
+	fieldViolations []appkiterrors.FieldViolation
+	quotaViolations map[string]string
+	retryAfter      time.Duration
+	errorInfo       *errdetails.ErrorInfo
+}
+
+// FromStatus returns a StatusError decoded from a gRPC status.
+// It returns nil if the status code is OK.
+//
+// Validation violations are reconstructed from BadRequest field violations (see NewValidationStatusMatcher),
+// preserving their order and using their reason as the violation code.
+func FromStatus(st *status.Status) error {
+	if st.Code() == codes.OK {
+		return nil
+	}
+
+	serr := &StatusError{
+		status: st,
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range d.GetFieldViolations() {
+				serr.fieldViolations = append(serr.fieldViolations, appkiterrors.FieldViolation{
+					Path:    fieldPath(violation.GetField()),
+					Code:    violation.GetReason(),
+					Message: violation.GetDescription(),
+				})
+			}
+
+		case *errdetails.QuotaFailure:
+			if serr.quotaViolations == nil {
+				serr.quotaViolations = make(map[string]string)
+			}
+
+			for _, violation := range d.GetViolations() {
+				serr.quotaViolations[violation.GetSubject()] = violation.GetDescription()
+			}
+
+		case *errdetails.RetryInfo:
+			serr.retryAfter = d.GetRetryDelay().AsDuration()
+
+		case *errdetails.ErrorInfo:
+			serr.errorInfo = d
+		}
+	}
+
+	return serr
+}
+
+// fieldPath splits a dot-separated field name into a path (see errors.FieldViolation.Field).
+func fieldPath(field string) []string {
+	if field == "" {
+		return nil
+	}
+
+	return strings.Split(field, ".")
+}
+
+// FromError returns a StatusError decoded from the gRPC status carried by an error.
+// If the error does not carry a gRPC status, it is returned unchanged.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	return FromStatus(st)
+}
+
+func (e *StatusError) Error() string {
+	return e.status.Message()
+}
+
+// GRPCStatus returns the original gRPC status.
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// NotFound implements the NotFound error behavior.
+func (e *StatusError) NotFound() bool {
+	return e.status.Code() == codes.NotFound
+}
+
+// Validation implements the Validation error behavior.
+func (e *StatusError) Validation() bool {
+	return e.status.Code() == codes.InvalidArgument
+}
+
+// Violations returns the validation violations of the status (if any) grouped by field.
+func (e *StatusError) Violations() map[string][]string {
+	if e.fieldViolations == nil {
+		return nil
+	}
+
+	return appkiterrors.FieldViolationsToMap(e.fieldViolations)
+}
+
+// FieldViolations returns the ordered list of validation violations of the status (if any).
+func (e *StatusError) FieldViolations() []appkiterrors.FieldViolation {
+	return e.fieldViolations
+}
+
+// BadRequest implements the BadRequest error behavior.
+func (e *StatusError) BadRequest() bool {
+	return e.status.Code() == codes.InvalidArgument
+}
+
+// Conflict implements the Conflict error behavior.
+func (e *StatusError) Conflict() bool {
+	return e.status.Code() == codes.FailedPrecondition
+}
+
+// Unauthenticated implements the Unauthenticated error behavior.
+func (e *StatusError) Unauthenticated() bool {
+	return e.status.Code() == codes.Unauthenticated
+}
+
+// PermissionDenied implements the PermissionDenied error behavior.
+func (e *StatusError) PermissionDenied() bool {
+	return e.status.Code() == codes.PermissionDenied
+}
+
+// TooManyRequests implements the TooManyRequests error behavior.
+func (e *StatusError) TooManyRequests() bool {
+	return e.status.Code() == codes.ResourceExhausted
+}
+
+// RetryAfter returns the duration after which the request may be retried (taken from the RetryInfo detail).
+func (e *StatusError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// QuotaViolations returns quota violation descriptions keyed by their subject (taken from the QuotaFailure detail).
+func (e *StatusError) QuotaViolations() map[string]string {
+	return e.quotaViolations
+}
+
+// Unavailable implements the Unavailable error behavior.
+func (e *StatusError) Unavailable() bool {
+	return e.status.Code() == codes.Unavailable
+}
+
+// TimeoutError implements the Timeout error behavior.
+func (e *StatusError) TimeoutError() bool {
+	return e.status.Code() == codes.DeadlineExceeded
+}
+
+// PreconditionFailed implements the PreconditionFailed error behavior.
+func (e *StatusError) PreconditionFailed() bool {
+	return e.status.Code() == codes.FailedPrecondition
+}
+
+// AlreadyExists implements the AlreadyExists error behavior.
+func (e *StatusError) AlreadyExists() bool {
+	return e.status.Code() == codes.AlreadyExists
+}
+
+// NotImplemented implements the NotImplemented error behavior.
+func (e *StatusError) NotImplemented() bool {
+	return e.status.Code() == codes.Unimplemented
+}
+
+// ErrorCode returns the error code of the status (taken from the reason of the ErrorInfo detail).
+func (e *StatusError) ErrorCode() string {
+	return e.errorInfo.GetReason()
+}
+
+// Details returns the metadata of the ErrorInfo detail.
+func (e *StatusError) Details() map[string]interface{} {
+	metadata := e.errorInfo.GetMetadata()
+
+	details := make(map[string]interface{}, len(metadata))
+
+	for key, value := range metadata {
+		details[key] = value
+	}
+
+	return details
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func TestFromStatus(t *testing.T) {
+	tests := []struct {
+		code    codes.Code
+		matcher func(err error) bool
+	}{
+		{
+			code:    codes.NotFound,
+			matcher: appkiterrors.IsNotFoundError,
+		},
+		{
+			code:    codes.InvalidArgument,
+			matcher: appkiterrors.IsValidationError,
+		},
+		{
+			code:    codes.InvalidArgument,
+			matcher: appkiterrors.IsBadRequestError,
+		},
+		{
+			code:    codes.FailedPrecondition,
+			matcher: appkiterrors.IsConflictError,
+		},
+		{
+			code:    codes.Unauthenticated,
+			matcher: appkiterrors.IsUnauthenticatedError,
+		},
+		{
+			code:    codes.PermissionDenied,
+			matcher: appkiterrors.IsPermissionDeniedError,
+		},
+		{
+			code:    codes.ResourceExhausted,
+			matcher: appkiterrors.IsTooManyRequestsError,
+		},
+		{
+			code:    codes.Unavailable,
+			matcher: appkiterrors.IsUnavailableError,
+		},
+		{
+			code:    codes.DeadlineExceeded,
+			matcher: appkiterrors.IsTimeoutError,
+		},
+		{
+			code:    codes.FailedPrecondition,
+			matcher: appkiterrors.IsPreconditionFailedError,
+		},
+		{
+			code:    codes.AlreadyExists,
+			matcher: appkiterrors.IsAlreadyExistsError,
+		},
+		{
+			code:    codes.Unimplemented,
+			matcher: appkiterrors.IsNotImplementedError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.code.String(), func(t *testing.T) {
+			err := FromStatus(status.New(test.code, "error"))
+
+			if !test.matcher(err) {
+				t.Error("error is supposed to implement the matching behavior")
+			}
+
+			if want, have := "error", err.Error(); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+
+			if want, have := test.code, status.Code(err); want != have {
+				t.Errorf("unexpected code\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestFromStatus_FieldViolations(t *testing.T) {
+	expected := []appkiterrors.FieldViolation{
+		{Path: []string{"name"}, Code: "required", Message: "name is required"},
+		{Path: []string{"addresses", "0", "city"}, Code: "required", Message: "city is required"},
+		{Path: []string{"email"}, Code: "email", Message: "invalid email"},
+	}
+
+	st := NewDefaultStatusConverter().NewStatus(context.Background(), appkiterrors.NewFieldValidation("invalid user", expected))
+
+	violations, ok := appkiterrors.FieldViolations(FromStatus(st))
+	if !ok {
+		t.Fatal("error is supposed to carry field violations")
+	}
+
+	if !reflect.DeepEqual(expected, violations) {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", expected, violations)
+	}
+}
+
+func TestFromStatus_OK(t *testing.T) {
+	if err := FromStatus(status.New(codes.OK, "")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFromStatus_Validation(t *testing.T) {
+	st := NewDefaultStatusConverter().NewStatus(context.Background(), validationWithViolationsStub{})
+
+	err := FromStatus(st)
+
+	var verr interface {
+		Violations() map[string][]string
+	}
+
+	if !appkiterrors.IsValidationError(err) || !errors.As(err, &verr) {
+		t.Fatal("error is supposed to be a validation error with violations")
+	}
+
+	if want, have := "violation", verr.Violations()["field"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestFromStatus_TooManyRequests(t *testing.T) {
+	st := NewDefaultStatusConverter().NewStatus(context.Background(), tooManyRequestsWithDetailsStub{})
+
+	err := FromStatus(st)
+
+	retryAfter, ok := appkiterrors.RetryAfter(err)
+	if !ok {
+		t.Fatal("error is supposed to carry retry information")
+	}
+
+	if want, have := time.Minute, retryAfter; want != have {
+		t.Errorf("unexpected duration\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	var qerr quotaViolationError
+
+	if !errors.As(err, &qerr) {
+		t.Fatal("error is supposed to carry quota violations")
+	}
+
+	if want, have := "daily limit exceeded", qerr.QuotaViolations()["project:123"]; want != have {
+		t.Errorf("unexpected quota violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestFromStatus_ErrorInfo(t *testing.T) {
+	st := NewStatusConverter(
+		WithStatusMatchers(NewStatusCodeMatcher(codes.NotFound, func(err error) bool { return true })),
+	).NewStatus(context.Background(), detailsStub{})
+
+	err := FromStatus(st)
+
+	details, ok := appkiterrors.Details(err)
+	if !ok {
+		t.Fatal("error is supposed to carry details")
+	}
+
+	if want, have := "1", details["id"]; want != have {
+		t.Errorf("unexpected detail\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	t.Run("status", func(t *testing.T) {
+		err := FromError(status.Error(codes.NotFound, "not found"))
+
+		if !appkiterrors.IsNotFoundError(err) {
+			t.Error("error is supposed to be a NotFound error")
+		}
+	})
+
+	t.Run("non_status", func(t *testing.T) {
+		if err := FromError(io.EOF); !errors.Is(err, io.EOF) {
+			t.Error("error is supposed to be returned unchanged")
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if err := FromError(nil); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/sagikazarmark/appkit/i18n"
+)
+
+// statusLocalizer returns a localizer for the locales accepted by the client
+// (based on the "accept-language" key of the incoming metadata stored in the context).
+func statusLocalizer(ctx context.Context, catalog i18n.Catalog) i18n.Localizer {
+	acceptLanguage := strings.Join(metadata.ValueFromIncomingContext(ctx, "accept-language"), ",")
+
+	return i18n.NewLocalizer(catalog, i18n.ParseAcceptLanguage(acceptLanguage)...)
+}
+
+// localizeStatus attaches a LocalizedMessage detail with the translated status message to a status
+// and translates the descriptions of field violations (if any).
+//
+// Other details are preserved as they are (even if they cannot be decoded).
+func localizeStatus(st *status.Status, localizer i18n.Localizer) *status.Status {
+	proto := st.Proto()
+
+	var localized bool
+
+	for i, detail := range proto.GetDetails() {
+		if !detail.MessageIs(&errdetails.BadRequest{}) {
+			continue
+		}
+
+		var br errdetails.BadRequest
+
+		if err := detail.UnmarshalTo(&br); err != nil {
+			continue
+		}
+
+		var localizedViolations bool
+
+		for _, violation := range br.GetFieldViolations() {
+			if message, locale := localizer.Localize(violation.GetDescription()); locale != "" {
+				violation.LocalizedMessage = &errdetails.LocalizedMessage{
+					Locale:  locale,
+					Message: message,
+				}
+
+				localizedViolations = true
+			}
+		}
+
+		if !localizedViolations {
+			continue
+		}
+
+		proto.Details[i] = newAny(&br)
+		localized = true
+	}
+
+	if message, locale := localizer.Localize(st.Message()); locale != "" {
+		proto.Details = append(proto.Details, newAny(&errdetails.LocalizedMessage{
+			Locale:  locale,
+			Message: message,
+		}))
+
+		localized = true
+	}
+
+	if !localized {
+		return st
+	}
+
+	return status.FromProto(proto)
+}
+
+func newAny(detail proto.Message) *anypb.Any {
+	a, err := anypb.New(detail)
+	if err != nil {
+		// Marshaling well-known detail types should never fail.
+		panic(fmt.Errorf("unexpected error marshaling detail: %w", err))
+	}
+
+	return a
+}
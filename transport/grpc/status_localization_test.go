@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/sagikazarmark/appkit/i18n"
+)
+
+func TestWithLocalization(t *testing.T) {
+	catalog := i18n.NewMemoryCatalog()
+	catalog.Set("hu", "validation", "érvénytelen kérés")
+	catalog.Set("hu", "violation", "érvénytelen érték")
+
+	converter := NewDefaultStatusConverter(WithLocalization(catalog))
+
+	t.Run("localized", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "de, hu;q=0.8"))
+
+		st := converter.NewStatus(ctx, validationWithViolationsStub{})
+
+		testStatusEquals(t, st, codes.InvalidArgument, "validation")
+
+		details := st.Details()
+
+		violation := details[0].(*errdetails.BadRequest).GetFieldViolations()[0]
+
+		if want, have := "érvénytelen érték", violation.GetLocalizedMessage().GetMessage(); want != have {
+			t.Errorf("unexpected localized violation\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		localizedMessage, ok := details[1].(*errdetails.LocalizedMessage)
+		if !ok {
+			t.Fatal("status is expected to contain a localized message")
+		}
+
+		if want, have := "hu", localizedMessage.GetLocale(); want != have {
+			t.Errorf("unexpected locale\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := "érvénytelen kérés", localizedMessage.GetMessage(); want != have {
+			t.Errorf("unexpected localized message\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("unsupported_locale", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "de"))
+
+		st := converter.NewStatus(ctx, validationWithViolationsStub{})
+
+		if want, have := 1, len(st.Details()); want != have {
+			t.Errorf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+
+	t.Run("undecodable_details", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "hu"))
+
+		converter := NewDefaultStatusConverter(
+			WithLocalization(catalog),
+			WithStatusMatchers(NewStatusPassthroughMatcher()),
+		)
+
+		unknown := &anypb.Any{TypeUrl: "type.googleapis.com/unknown.Detail", Value: []byte("unknown")}
+
+		err := status.FromProto(&spb.Status{
+			Code:    int32(codes.InvalidArgument),
+			Message: "validation",
+			Details: []*anypb.Any{unknown},
+		}).Err()
+
+		st := converter.NewStatus(ctx, err)
+
+		testStatusEquals(t, st, codes.InvalidArgument, "validation")
+
+		details := st.Proto().GetDetails()
+
+		if want, have := 2, len(details); want != have {
+			t.Fatalf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		if !proto.Equal(unknown, details[0]) {
+			t.Error("undecodable detail is supposed to be preserved")
+		}
+
+		if !details[1].MessageIs(&errdetails.LocalizedMessage{}) {
+			t.Error("status is expected to contain a localized message")
+		}
+	})
+}
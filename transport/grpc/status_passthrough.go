@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewStatusPassthroughMatcher returns a status matcher for errors that already carry a gRPC status
+// (eg. errors returned by downstream gRPC calls, see FromError).
+// An error carries a status if it implements the following interface:
+//
+//	type grpcStatus interface {
+//		GRPCStatus() *status.Status
+//	}
+//
+// The returned status is the original status (including its message and details),
+// unless its code is remapped (see WithPassthroughCodeMapping).
+// The message is not redacted (see WithRedaction).
+//
+// By default statuses with any (non-OK) code propagate.
+// Use WithPassthroughCodes to restrict the codes allowed to propagate.
+//
+// Since StatusError implements the error behaviors matched by the default status matchers,
+// the passthrough matcher should precede them (which is the case when it is passed to NewDefaultStatusConverter).
+//
+// Server interceptors return errors carrying a status unchanged by default:
+// use WithStatusErrorConversion to pass them to the converter (and the passthrough matcher).
+func NewStatusPassthroughMatcher(opts ...StatusPassthroughOption) StatusMatcher {
+	m := statusPassthroughMatcher{}
+
+	for _, opt := range opts {
+		opt.apply(&m)
+	}
+
+	return m
+}
+
+type statusPassthroughMatcher struct {
+	codes       map[codes.Code]bool
+	codeMapping map[codes.Code]codes.Code
+}
+
+// StatusPassthroughOption configures a status passthrough matcher using the functional options paradigm
+// popularized by Rob Pike and Dave Cheney.
+// If you're unfamiliar with this style, see:
+// - https://commandcenter.blogspot.com/2014/01/self-referential-functions-and-design.html
+// - https://dave.cheney.net/2014/10/17/functional-options-for-friendly-apis.
+type StatusPassthroughOption interface {
+	apply(m *statusPassthroughMatcher)
+}
+
+type statusPassthroughOptionFunc func(*statusPassthroughMatcher)
+
+func (f statusPassthroughOptionFunc) apply(m *statusPassthroughMatcher) { f(m) }
+
+// WithPassthroughCodes configures a status passthrough matcher to only match statuses with the listed codes.
+// Codes are checked before remapping them (see WithPassthroughCodeMapping).
+// Errors carrying a status with any other code are left to the subsequent matchers.
+func WithPassthroughCodes(allowedCodes ...codes.Code) StatusPassthroughOption {
+	return statusPassthroughOptionFunc(func(m *statusPassthroughMatcher) {
+		if m.codes == nil {
+			m.codes = make(map[codes.Code]bool, len(allowedCodes))
+		}
+
+		for _, code := range allowedCodes {
+			m.codes[code] = true
+		}
+	})
+}
+
+// WithPassthroughCodeMapping configures a status passthrough matcher to remap the code of statuses
+// (eg. downgrading NotFound statuses returned by a downstream service to Internal).
+// The message and the details of remapped statuses are preserved.
+//
+// Mappings to OK are ignored: an error must never be converted to a successful status.
+func WithPassthroughCodeMapping(from codes.Code, to codes.Code) StatusPassthroughOption {
+	return statusPassthroughOptionFunc(func(m *statusPassthroughMatcher) {
+		if to == codes.OK {
+			return
+		}
+
+		if m.codeMapping == nil {
+			m.codeMapping = make(map[codes.Code]codes.Code)
+		}
+
+		m.codeMapping[from] = to
+	})
+}
+
+func (m statusPassthroughMatcher) MatchError(err error) bool {
+	st, ok := statusOf(err)
+	if !ok || st.Code() == codes.OK {
+		return false
+	}
+
+	return m.codes == nil || m.codes[st.Code()]
+}
+
+func (m statusPassthroughMatcher) NewStatus(_ context.Context, err error) *status.Status {
+	st, ok := statusOf(err)
+	if !ok {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	if code, ok := m.codeMapping[st.Code()]; ok {
+		proto := st.Proto()
+		proto.Code = int32(code)
+
+		st = status.FromProto(proto)
+	}
+
+	return st
+}
+
+// unredacted marks passthrough statuses as exempt from redaction:
+// their message comes from the original status, not from the (possibly wrapped) error.
+func (statusPassthroughMatcher) unredacted() {}
+
+// statusOf returns the status carried by an error (if any).
+// Unlike status.FromError, it returns the original status of wrapped errors (without altering its message).
+func statusOf(err error) (*status.Status, bool) {
+	var serr interface {
+		GRPCStatus() *status.Status
+	}
+
+	if !errors.As(err, &serr) {
+		return nil, false
+	}
+
+	st := serr.GRPCStatus()
+
+	return st, st != nil
+}
@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func newDownstreamError(t *testing.T) error {
+	t.Helper()
+
+	st, err := status.New(codes.NotFound, "user not found").WithDetails(
+		&errdetails.ErrorInfo{Reason: "USER_NOT_FOUND", Domain: "users.example.com"},
+		&errdetails.ResourceInfo{ResourceType: "user", ResourceName: "1"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return st.Err()
+}
+
+func TestNewStatusPassthroughMatcher(t *testing.T) {
+	t.Run("passthrough", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(WithStatusMatchers(NewStatusPassthroughMatcher()))
+
+		tests := []error{
+			newDownstreamError(t),
+			fmt.Errorf("get user: %w", newDownstreamError(t)),
+			fmt.Errorf("get user: %w", FromError(newDownstreamError(t))),
+		}
+
+		for _, err := range tests {
+			st := converter.NewStatus(context.Background(), err)
+
+			testStatusEquals(t, st, codes.NotFound, "user not found")
+
+			if want, have := 2, len(st.Details()); want != have {
+				t.Fatalf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+			}
+
+			if want, have := "USER_NOT_FOUND", st.Details()[0].(*errdetails.ErrorInfo).GetReason(); want != have {
+				t.Errorf("unexpected reason\nexpected: %s\nactual:   %s", want, have)
+			}
+		}
+	})
+
+	t.Run("redaction", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(
+			WithStatusMatchers(NewStatusPassthroughMatcher()),
+			WithRedaction(appkiterrors.OuterMessage),
+		)
+
+		tests := []error{
+			newDownstreamError(t),
+			fmt.Errorf("get user: %w", newDownstreamError(t)),
+		}
+
+		for _, err := range tests {
+			testStatusEquals(t, converter.NewStatus(context.Background(), err), codes.NotFound, "user not found")
+		}
+	})
+
+	t.Run("allowed_codes", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(WithStatusMatchers(NewStatusPassthroughMatcher(
+			WithPassthroughCodes(codes.InvalidArgument),
+		)))
+
+		st := converter.NewStatus(context.Background(), newDownstreamError(t))
+
+		testStatusEquals(t, st, codes.Internal, "something went wrong")
+	})
+
+	t.Run("code_mapping", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(WithStatusMatchers(NewStatusPassthroughMatcher(
+			WithPassthroughCodeMapping(codes.NotFound, codes.Internal),
+		)))
+
+		st := converter.NewStatus(context.Background(), newDownstreamError(t))
+
+		testStatusEquals(t, st, codes.Internal, "user not found")
+
+		if want, have := 2, len(st.Details()); want != have {
+			t.Errorf("unexpected number of details\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+
+	t.Run("code_mapping_ok", func(t *testing.T) {
+		converter := NewDefaultStatusConverter(WithStatusMatchers(NewStatusPassthroughMatcher(
+			WithPassthroughCodeMapping(codes.NotFound, codes.OK),
+		)))
+
+		err := appkiterrors.WithErrorCode(newDownstreamError(t), "USER_NOT_FOUND")
+
+		st := converter.NewStatus(context.Background(), err)
+
+		testStatusEquals(t, st, codes.NotFound, "user not found")
+
+		if st.Err() == nil {
+			t.Error("status is NOT supposed to be OK")
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		converter := NewDefaultStatusConverter()
+
+		st := converter.NewStatus(context.Background(), newDownstreamError(t))
+
+		testStatusEquals(t, st, codes.Internal, "something went wrong")
+	})
+
+	t.Run("no_status", func(t *testing.T) {
+		matcher := NewStatusPassthroughMatcher()
+
+		if matcher.MatchError(fmt.Errorf("error")) {
+			t.Error("error is NOT supposed to be matched")
+		}
+
+		if matcher.MatchError(status.New(codes.OK, "").Err()) {
+			t.Error("error is NOT supposed to be matched")
+		}
+	})
+}
@@ -2,11 +2,18 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+
+	"github.com/sagikazarmark/appkit/transport/classification"
 )
 
 type notFoundStub struct{}
@@ -39,6 +46,86 @@ func (conflictStub) Conflict() bool {
 	return true
 }
 
+type unauthenticatedStub struct{}
+
+func (unauthenticatedStub) Error() string {
+	return "unauthenticated"
+}
+
+func (unauthenticatedStub) Unauthenticated() bool {
+	return true
+}
+
+type permissionDeniedStub struct{}
+
+func (permissionDeniedStub) Error() string {
+	return "permission denied"
+}
+
+func (permissionDeniedStub) PermissionDenied() bool {
+	return true
+}
+
+type tooManyRequestsStub struct{}
+
+func (tooManyRequestsStub) Error() string {
+	return "too many requests"
+}
+
+func (tooManyRequestsStub) TooManyRequests() bool {
+	return true
+}
+
+type unavailableStub struct{}
+
+func (unavailableStub) Error() string {
+	return "unavailable"
+}
+
+func (unavailableStub) Unavailable() bool {
+	return true
+}
+
+type timeoutStub struct{}
+
+func (timeoutStub) Error() string {
+	return "timeout"
+}
+
+func (timeoutStub) TimeoutError() bool {
+	return true
+}
+
+type preconditionFailedStub struct{}
+
+func (preconditionFailedStub) Error() string {
+	return "precondition failed"
+}
+
+func (preconditionFailedStub) PreconditionFailed() bool {
+	return true
+}
+
+type alreadyExistsStub struct{}
+
+func (alreadyExistsStub) Error() string {
+	return "already exists"
+}
+
+func (alreadyExistsStub) AlreadyExists() bool {
+	return true
+}
+
+type notImplementedStub struct{}
+
+func (notImplementedStub) Error() string {
+	return "not implemented"
+}
+
+func (notImplementedStub) NotImplemented() bool {
+	return true
+}
+
 func TestDefaultStatusMatchers(t *testing.T) {
 	tests := []struct {
 		err          error
@@ -56,6 +143,38 @@ func TestDefaultStatusMatchers(t *testing.T) {
 			err:          conflictStub{},
 			expectedCode: codes.FailedPrecondition,
 		},
+		{
+			err:          unauthenticatedStub{},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			err:          permissionDeniedStub{},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			err:          tooManyRequestsStub{},
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			err:          unavailableStub{},
+			expectedCode: codes.Unavailable,
+		},
+		{
+			err:          timeoutStub{},
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			err:          preconditionFailedStub{},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			err:          alreadyExistsStub{},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			err:          notImplementedStub{},
+			expectedCode: codes.Unimplemented,
+		},
 	}
 
 	converter := NewDefaultStatusConverter()
@@ -123,3 +242,158 @@ func TestDefaultStatusMatchers_ValidationWithViolations(t *testing.T) {
 		t.Errorf("unexpected violation description\nexpected: %s\nactual:   %s", want, have)
 	}
 }
+
+type tooManyRequestsWithDetailsStub struct {
+	tooManyRequestsStub
+}
+
+func (tooManyRequestsWithDetailsStub) RetryAfter() time.Duration {
+	return time.Minute
+}
+
+func (tooManyRequestsWithDetailsStub) QuotaViolations() map[string]string {
+	return map[string]string{
+		"project:123": "daily limit exceeded",
+	}
+}
+
+func TestDefaultStatusMatchers_TooManyRequestsWithDetails(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := tooManyRequestsWithDetailsStub{}
+
+	st := converter.NewStatus(context.Background(), err)
+
+	if want, have := codes.ResourceExhausted, st.Code(); want != have {
+		t.Errorf("unexpected status code\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := err.Error(), st.Message(); want != have {
+		t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	if !ok {
+		t.Fatal("status is expected to contain retry information")
+	}
+
+	if want, have := time.Minute, retryInfo.GetRetryDelay().AsDuration(); want != have {
+		t.Errorf("unexpected retry delay\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	quotaFailure, ok := st.Details()[1].(*errdetails.QuotaFailure)
+	if !ok {
+		t.Fatal("status is expected to contain quota failure information")
+	}
+
+	if want, have := "project:123", quotaFailure.GetViolations()[0].GetSubject(); want != have {
+		t.Errorf("unexpected quota violation subject\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "daily limit exceeded", quotaFailure.GetViolations()[0].GetDescription(); want != have {
+		t.Errorf("unexpected quota violation description\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewStatusMatchers(t *testing.T) {
+	converter := NewStatusConverter(WithStatusMatchers(NewStatusMatchers([]classification.Class{
+		{Name: "aborted", Matcher: func(err error) bool { return err.Error() == "aborted" }, GRPCCode: codes.Aborted},
+		{Name: "http-only", Matcher: func(err error) bool { return true }, HTTPStatus: http.StatusTeapot},
+	})...))
+
+	if want, have := codes.Aborted, converter.NewStatus(context.Background(), errors.New("aborted")).Code(); want != have {
+		t.Errorf("unexpected status code\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := codes.Internal, converter.NewStatus(context.Background(), errors.New("error")).Code(); want != have {
+		t.Errorf("unexpected status code\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+type violationsStub struct{}
+
+func (violationsStub) Error() string {
+	return "invalid input"
+}
+
+func (violationsStub) Violations() map[string][]string {
+	return map[string][]string{
+		"field": {
+			"violation",
+		},
+	}
+}
+
+type retryAfterStub struct{}
+
+func (retryAfterStub) Error() string {
+	return "overloaded"
+}
+
+func (retryAfterStub) RetryAfter() time.Duration {
+	return time.Minute
+}
+
+func TestNewStatusMatchers_SpecialClasses(t *testing.T) {
+	converter := NewStatusConverter(WithStatusMatchers(NewStatusMatchers([]classification.Class{
+		{
+			Name:     classification.Validation,
+			Matcher:  func(err error) bool { return errors.As(err, &violationsStub{}) },
+			GRPCCode: codes.FailedPrecondition,
+		},
+		{
+			Name:     classification.TooManyRequests,
+			Matcher:  func(err error) bool { return errors.As(err, &retryAfterStub{}) },
+			GRPCCode: codes.Unavailable,
+		},
+	})...))
+
+	t.Run("validation", func(t *testing.T) {
+		st := converter.NewStatus(context.Background(), violationsStub{})
+
+		testStatusEquals(t, st, codes.FailedPrecondition, "invalid input")
+
+		if len(st.Details()) == 0 {
+			t.Fatal("status is expected to contain violation information")
+		}
+
+		if _, ok := st.Details()[0].(*errdetails.BadRequest); !ok {
+			t.Error("status is expected to contain violation information")
+		}
+	})
+
+	t.Run("too_many_requests", func(t *testing.T) {
+		st := converter.NewStatus(context.Background(), retryAfterStub{})
+
+		testStatusEquals(t, st, codes.Unavailable, "overloaded")
+
+		if len(st.Details()) == 0 {
+			t.Fatal("status is expected to contain retry information")
+		}
+
+		if _, ok := st.Details()[0].(*errdetails.RetryInfo); !ok {
+			t.Error("status is expected to contain retry information")
+		}
+	})
+
+	t.Run("default_matchers", func(t *testing.T) {
+		for _, err := range []error{validationWithViolationsStub{}, tooManyRequestsWithDetailsStub{}} {
+			if want, have := codes.Internal, converter.NewStatus(context.Background(), err).Code(); want != have {
+				t.Errorf("unexpected status code\nexpected: %s\nactual:   %s", want, have)
+			}
+		}
+	})
+}
+
+func TestDefaultStatusMatchers_NetTimeout(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := fmt.Errorf("query users: %w", &net.OpError{
+		Op:   "dial",
+		Net:  "tcp",
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 5), Port: 5432},
+		Err:  os.ErrDeadlineExceeded,
+	})
+
+	testStatusEquals(t, converter.NewStatus(context.Background(), err), codes.Internal, "something went wrong")
+}
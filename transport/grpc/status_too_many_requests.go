@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// NewTooManyRequestsStatusMatcher returns a status matcher for rate limit and quota exhaustion errors.
+// If the returned error matches the following interface, retry info gets attached to the returned status:
+//
+//	type retryAfter interface {
+//		RetryAfter() time.Duration
+//	}
+//
+// If the returned error matches the following interface, quota failure info gets attached to the returned status:
+//
+//	type quotaViolationError interface {
+//		// QuotaViolations returns quota violation descriptions keyed by their subject.
+//		QuotaViolations() map[string]string
+//	}
+func NewTooManyRequestsStatusMatcher() StatusMatcher {
+	return newTooManyRequestsStatusMatcher(appkiterrors.IsTooManyRequestsError, codes.ResourceExhausted)
+}
+
+// newTooManyRequestsStatusMatcher returns a status matcher attaching retry and quota failure info to statuses
+// of errors matched by a custom matcher.
+func newTooManyRequestsStatusMatcher(matcher func(err error) bool, code codes.Code) StatusMatcher {
+	return tooManyRequestsStatusConverter{
+		matcher: matcher,
+		code:    code,
+	}
+}
+
+type quotaViolationError interface {
+	QuotaViolations() map[string]string
+}
+
+type tooManyRequestsStatusConverter struct {
+	matcher func(err error) bool
+	code    codes.Code
+}
+
+func (c tooManyRequestsStatusConverter) MatchError(err error) bool {
+	return c.matcher(err)
+}
+
+func (c tooManyRequestsStatusConverter) NewStatus(_ context.Context, err error) *status.Status {
+	st := status.New(c.code, err.Error())
+
+	var details []protoadapt.MessageV1
+
+	if retryAfter, ok := appkiterrors.RetryAfter(err); ok {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryAfter),
+		})
+	}
+
+	var qerr quotaViolationError
+
+	if errors.As(err, &qerr) {
+		violations := qerr.QuotaViolations()
+
+		subjects := make([]string, 0, len(violations))
+		for subject := range violations {
+			subjects = append(subjects, subject)
+		}
+
+		sort.Strings(subjects)
+
+		qf := &errdetails.QuotaFailure{}
+
+		for _, subject := range subjects {
+			qf.Violations = append(qf.Violations, &errdetails.QuotaFailure_Violation{
+				Subject:     subject,
+				Description: violations[subject],
+			})
+		}
+
+		details = append(details, qf)
+	}
+
+	return withDetails(st, details...)
+}
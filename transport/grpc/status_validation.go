@@ -2,8 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -18,45 +16,62 @@ import (
 //	type violationError interface {
 //		Violations() map[string][]string
 //	}
+//
+// Errors carrying an ordered list of field violations (see errors.FieldViolations) are also supported:
+// the order of violations is preserved, the field path is joined with dots and the violation code is used as the reason.
+// Violations grouped by field are ordered by field name.
+//
+// Violations of joined errors (see errors.Join) are merged into a single BadRequest detail.
 func NewValidationStatusMatcher() StatusMatcher {
-	return validationStatusConverter{}
+	return newViolationStatusMatcher(appkiterrors.IsValidationError, codes.InvalidArgument)
 }
 
-type validationStatusConverter struct{}
-
-func (v validationStatusConverter) MatchError(err error) bool {
-	return appkiterrors.IsValidationError(err)
+// NewBadRequestStatusMatcher returns a status matcher for bad request errors.
+// Violation info gets attached to the returned status the same way as for validation errors
+// (see NewValidationStatusMatcher).
+func NewBadRequestStatusMatcher() StatusMatcher {
+	return newViolationStatusMatcher(appkiterrors.IsBadRequestError, codes.InvalidArgument)
 }
 
-func (v validationStatusConverter) NewStatus(_ context.Context, err error) *status.Status {
-	var verr interface {
-		Violations() map[string][]string
+// newViolationStatusMatcher returns a status matcher attaching violation info to statuses
+// of errors matched by a custom matcher.
+func newViolationStatusMatcher(matcher func(err error) bool, code codes.Code) StatusMatcher {
+	return violationStatusConverter{
+		matcher: matcher,
+		code:    code,
 	}
+}
 
-	if errors.As(err, &verr) {
-		st := status.New(codes.InvalidArgument, err.Error())
+type violationStatusConverter struct {
+	matcher func(err error) bool
+	code    codes.Code
+}
 
-		br := &errdetails.BadRequest{}
+func (v violationStatusConverter) MatchError(err error) bool {
+	return v.matcher(err)
+}
 
-		for field, violations := range verr.Violations() {
-			for _, violation := range violations {
-				br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       field,
-					Description: violation,
-				})
-			}
-		}
+func (v violationStatusConverter) NewStatus(_ context.Context, err error) *status.Status {
+	return newViolationStatus(v.code, err)
+}
+
+// newViolationStatus returns a status with a BadRequest detail if the error carries violations.
+func newViolationStatus(code codes.Code, err error) *status.Status {
+	st := status.New(code, err.Error())
+
+	if violations, ok := appkiterrors.FieldViolations(err); ok {
+		br := &errdetails.BadRequest{}
 
-		st, err := st.WithDetails(br)
-		if err != nil {
-			// If this errored, it will always error
-			// here, so better panic so we can figure
-			// out why than have this silently passing.
-			panic(fmt.Errorf("unexpected error attaching metadata: %w", err))
+		for _, violation := range violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field(),
+				Description: violation.Message,
+				Reason:      violation.Code,
+			})
 		}
 
-		return st
+		return withDetails(st, br)
 	}
 
-	return status.New(codes.InvalidArgument, err.Error())
+	return st
 }
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func TestValidationStatusMatcher_Joined(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := errors.Join(
+		appkiterrors.NewValidation("invalid user", map[string][]string{"email": {"required"}}),
+		appkiterrors.NewValidation("invalid address", map[string][]string{"email": {"invalid"}}),
+	)
+
+	st := converter.NewStatus(context.Background(), err)
+
+	if want, have := codes.InvalidArgument, st.Code(); want != have {
+		t.Errorf("unexpected code\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatal("status is expected to contain a bad request detail")
+	}
+
+	var descriptions []string
+
+	for _, violation := range br.GetFieldViolations() {
+		descriptions = append(descriptions, violation.GetField()+": "+violation.GetDescription())
+	}
+
+	if want, have := []string{"email: required", "email: invalid"}, descriptions; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestValidationStatusMatcher_FieldViolations(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := appkiterrors.NewFieldValidation("invalid user", []appkiterrors.FieldViolation{
+		{Path: []string{"name"}, Code: "required", Message: "name is required"},
+		{Path: []string{"addresses", "0", "city"}, Code: "required", Message: "city is required"},
+		{Path: []string{"email"}, Code: "invalid", Message: "email is invalid"},
+	})
+
+	st := converter.NewStatus(context.Background(), err)
+
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatal("status is expected to contain a bad request detail")
+	}
+
+	var violations []string
+
+	for _, violation := range br.GetFieldViolations() {
+		violations = append(violations, violation.GetField()+" ("+violation.GetReason()+"): "+violation.GetDescription())
+	}
+
+	expected := []string{
+		"name (required): name is required",
+		"addresses.0.city (required): city is required",
+		"email (invalid): email is invalid",
+	}
+
+	if want, have := expected, violations; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestValidationStatusMatcher_Ordered(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := appkiterrors.NewValidation("invalid user", map[string][]string{
+		"name":  {"required"},
+		"email": {"required", "invalid"},
+		"city":  {"required"},
+	})
+
+	br := converter.NewStatus(context.Background(), err).Details()[0].(*errdetails.BadRequest)
+
+	var fields []string
+
+	for _, violation := range br.GetFieldViolations() {
+		fields = append(fields, violation.GetField())
+	}
+
+	if want, have := []string{"city", "email", "email", "name"}, fields; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected field order\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestDefaultStatusMatchers_Precedence(t *testing.T) {
+	converter := NewDefaultStatusConverter()
+
+	err := errors.Join(
+		appkiterrors.NewValidation("invalid user", map[string][]string{"email": {"required"}}),
+		appkiterrors.NewNotFound("user", "1"),
+	)
+
+	if want, have := codes.NotFound, converter.NewStatus(context.Background(), err).Code(); want != have {
+		t.Errorf("unexpected code\nexpected: %s\nactual:   %s", want, have)
+	}
+}
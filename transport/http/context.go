@@ -0,0 +1,60 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type contextKey int
+
+const requestContextKey contextKey = iota
+
+// PopulateRequestContext stores the request in the context,
+// so that request information (eg. headers used for content negotiation) is available for converting errors to problems.
+//
+// PopulateRequestContext is compatible with go-kit's RequestFunc, so it can be used as a ServerBefore option.
+func PopulateRequestContext(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, requestContextKey, r)
+}
+
+// requestFromContext returns the request stored in the context by PopulateRequestContext (if any).
+func requestFromContext(ctx context.Context) (*http.Request, bool) {
+	r, ok := ctx.Value(requestContextKey).(*http.Request)
+
+	return r, ok && r != nil
+}
+
+// RequestIDExtractor extracts a request ID (eg. correlation ID) from the context.
+// It returns an empty string if the context does not contain a request ID.
+type RequestIDExtractor func(ctx context.Context) string
+
+// HeaderRequestIDExtractor returns a RequestIDExtractor that reads the request ID
+// from a header of the request stored in the context (see PopulateRequestContext).
+func HeaderRequestIDExtractor(header string) RequestIDExtractor {
+	return func(ctx context.Context) string {
+		r, ok := requestFromContext(ctx)
+		if !ok {
+			return ""
+		}
+
+		return r.Header.Get(header)
+	}
+}
+
+// problemInstance returns a URI reference identifying the specific occurrence of a problem.
+func problemInstance(ctx context.Context, requestID RequestIDExtractor) string {
+	var instance url.URL
+
+	if r, ok := requestFromContext(ctx); ok {
+		instance.Path = r.URL.Path
+	}
+
+	if requestID != nil {
+		if id := requestID(ctx); id != "" {
+			instance.RawQuery = url.Values{"request_id": {id}}.Encode()
+		}
+	}
+
+	return instance.String()
+}
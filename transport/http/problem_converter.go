@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 
 	"github.com/moogar0880/problems"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+	"github.com/sagikazarmark/appkit/i18n"
 )
 
 // ProblemConverter converts an error to a RFC-7807 Problem.
@@ -46,6 +50,9 @@ type statusProblemMatcher struct {
 }
 
 // ErrorMatcher checks if an error matches a certain condition.
+//
+// See errors.MatchAny, errors.MatchAll, errors.Not, errors.MatchIs, errors.MatchAs, errors.MatchMessage
+// and errors.MatchRegexp for building matchers.
 type ErrorMatcher func(err error) bool
 
 // NewStatusProblemMatcher returns a new StatusProblemMatcher.
@@ -79,7 +86,10 @@ func (c defaultProblemConverter) NewProblem(_ context.Context, err error) interf
 }
 
 func (c defaultProblemConverter) NewStatusProblem(_ context.Context, status int, err error) StatusProblem {
-	return problems.NewDetailedProblem(status, err.Error())
+	problem := problems.NewDetailedProblem(status, err.Error())
+	problem.Title = statusText(status)
+
+	return problem
 }
 
 type problemConverter struct {
@@ -87,6 +97,21 @@ type problemConverter struct {
 
 	problemConverter       ProblemConverter
 	statusProblemConverter StatusProblemConverter
+
+	errorCodeTypeURI string
+	validationErrors bool
+	problemTypes     *ProblemTypeRegistry
+
+	instance  bool
+	requestID RequestIDExtractor
+
+	catalog i18n.Catalog
+
+	redactor appkiterrors.Redactor
+
+	debug bool
+
+	withoutContextErrors bool
 }
 
 // ProblemConverterOption configures a ProblemConverter using the functional options paradigm
@@ -148,6 +173,96 @@ func WithStatusProblemConverter(converter StatusProblemConverter) ProblemConvert
 	})
 }
 
+// WithErrorCodeTypeURI configures a ProblemConverter to derive the problem type URI from error codes.
+// The type URI of a problem is set to the base URI followed by the error code (if the error carries one).
+//
+// See errors.ErrorCode for details about error codes.
+func WithErrorCodeTypeURI(baseURI string) ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.errorCodeTypeURI = baseURI
+	})
+}
+
+// WithProblemTypes configures a ProblemConverter to set the type URI and the title of problems
+// from the first registered problem type matching the error.
+// Problem types take precedence over type URIs derived from error codes (see WithErrorCodeTypeURI).
+func WithProblemTypes(registry *ProblemTypeRegistry) ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.problemTypes = registry
+	})
+}
+
+// WithProblemInstance configures a ProblemConverter to populate the instance member of problems
+// with the request path (see PopulateRequestContext) and a request ID (eg. correlation ID) extracted from the context,
+// so that a problem reported by a client can be tied to server logs.
+//
+// The request ID is added to the instance URI as a "request_id" query parameter.
+// If requestID is nil, only the request path is used.
+func WithProblemInstance(requestID RequestIDExtractor) ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.instance = true
+		c.requestID = requestID
+	})
+}
+
+// WithLocalization configures a ProblemConverter to translate the title, the detail and the validation violations
+// of problems using a message catalog.
+// The locale is resolved from the Accept-Language header of the request stored in the context
+// (see PopulateRequestContext).
+func WithLocalization(catalog i18n.Catalog) ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.catalog = catalog
+	})
+}
+
+// WithRedaction configures a ProblemConverter to compute the detail member of problems created for matched errors
+// using a Redactor instead of exposing the message of the error verbatim
+// (which may leak internal context carried by wrapped causes).
+//
+// For example:
+//   - errors.OuterMessage exposes the message of the outermost error only
+//   - errors.PublicMessageRedactor exposes messages explicitly marked as public
+//   - errors.FullMessage exposes the full chain of messages (useful in non-production environments)
+//
+// The fixed messages of problems created for context errors are not redacted.
+func WithRedaction(redactor appkiterrors.Redactor) ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.redactor = redactor
+	})
+}
+
+// WithDebug configures a ProblemConverter to add a "debug" extension member to problems
+// containing the full Unwrap chain of the error (including the branches of joined errors)
+// and its stack trace (if any).
+//
+// Debug information is added to every problem (including the ones created for unmatched errors).
+// It may contain sensitive information, so it should only be enabled in non-production environments.
+//
+// See errors.Chain and errors.StackTrace for details.
+func WithDebug() ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.debug = true
+	})
+}
+
+// WithoutContextErrors configures a ProblemConverter to exclude the default matchers of context errors
+// (context.Canceled and context.DeadlineExceeded), so that they are converted to HTTP 500 problems
+// (unless another matcher matches them).
+func WithoutContextErrors() ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.withoutContextErrors = true
+	})
+}
+
+// WithValidationErrors configures a ProblemConverter to report validation violations
+// as an RFC-9457 "errors" array (see ValidationErrorsProblem)
+// instead of the "violations" map of ValidationProblem.
+func WithValidationErrors() ProblemConverterOption {
+	return problemConverterOptionFunc(func(c *problemConverter) {
+		c.validationErrors = true
+	})
+}
+
 // NewProblemConverter returns a new ProblemConverter implementation.
 func NewProblemConverter(opts ...ProblemConverterOption) ProblemConverter {
 	c := problemConverter{}
@@ -156,6 +271,18 @@ func NewProblemConverter(opts ...ProblemConverterOption) ProblemConverter {
 		opt.apply(&c)
 	}
 
+	if c.withoutContextErrors {
+		matchers := make([]ProblemMatcher, 0, len(c.matchers))
+
+		for _, matcher := range c.matchers {
+			if _, ok := matcher.(contextErrorProblemMatcher); !ok {
+				matchers = append(matchers, matcher)
+			}
+		}
+
+		c.matchers = matchers
+	}
+
 	if c.problemConverter == nil {
 		c.problemConverter = defaultProblemConverter{}
 	}
@@ -172,17 +299,29 @@ func NewProblemConverter(opts ...ProblemConverterOption) ProblemConverter {
 }
 
 func (c problemConverter) NewProblem(ctx context.Context, err error) interface{} {
-	for _, matcher := range c.matchers {
-		if matcher.MatchError(err) {
-			if converter, ok := matcher.(ProblemConverter); ok {
-				return converter.NewProblem(ctx, err)
-			}
+	problem := c.matchProblem(ctx, err)
 
-			if statusMatcher, ok := matcher.(StatusProblemMatcher); ok {
-				return c.statusProblemConverter.NewStatusProblem(ctx, statusMatcher.Status(), err)
-			}
+	if c.catalog != nil {
+		localizeProblem(problem, problemLocalizer(ctx, c.catalog))
+	}
 
-			return c.problemConverter.NewProblem(ctx, err)
+	if c.instance {
+		if dp, ok := defaultProblemOf(problem); ok {
+			dp.Instance = problemInstance(ctx, c.requestID)
+		}
+	}
+
+	if c.debug {
+		problem = extendProblem(problem, map[string]interface{}{"debug": newProblemDebugInfo(err)})
+	}
+
+	return problem
+}
+
+func (c problemConverter) matchProblem(ctx context.Context, err error) interface{} {
+	for _, matcher := range c.matchers {
+		if matcher.MatchError(err) {
+			return c.decorateProblem(ctx, matcher, err, c.newProblem(ctx, matcher, err))
 		}
 	}
 
@@ -193,6 +332,78 @@ func (c problemConverter) NewProblem(ctx context.Context, err error) interface{}
 	)
 }
 
+func (c problemConverter) newProblem(ctx context.Context, matcher ProblemMatcher, err error) interface{} {
+	if converter, ok := matcher.(ProblemConverter); ok {
+		problem := converter.NewProblem(ctx, err)
+
+		if vp, ok := problem.(*ValidationProblem); ok && c.validationErrors {
+			problem := NewValidationErrorsProblem(vp.Detail, vp.Violations)
+
+			if violations, ok := appkiterrors.FieldViolations(err); ok {
+				problem = NewFieldValidationErrorsProblem(vp.Detail, violations)
+			}
+
+			setProblemStatus(problem.DefaultProblem, vp.Status)
+
+			return problem
+		}
+
+		return problem
+	}
+
+	if statusMatcher, ok := matcher.(StatusProblemMatcher); ok {
+		return c.statusProblemConverter.NewStatusProblem(ctx, statusMatcher.Status(), err)
+	}
+
+	return c.problemConverter.NewProblem(ctx, err)
+}
+
+// decorateProblem adds information carried by a matched error to the problem.
+func (c problemConverter) decorateProblem(
+	_ context.Context,
+	matcher ProblemMatcher,
+	err error,
+	problem interface{},
+) interface{} {
+	if _, ok := matcher.(unredactedProblemMatcher); c.redactor != nil && !ok {
+		if dp, ok := defaultProblemOf(problem); ok {
+			dp.Detail = c.redactor(err)
+		}
+	}
+
+	extensions := make(map[string]interface{})
+
+	if details, ok := appkiterrors.Details(err); ok {
+		for key, value := range details {
+			extensions[key] = value
+		}
+	}
+
+	if code, ok := appkiterrors.ErrorCode(err); ok {
+		extensions["code"] = code
+
+		if c.errorCodeTypeURI != "" {
+			if dp, ok := defaultProblemOf(problem); ok {
+				dp.Type = c.errorCodeTypeURI + url.PathEscape(code)
+			}
+		}
+	}
+
+	if c.problemTypes != nil {
+		if t, ok := c.problemTypes.Match(err); ok {
+			if dp, ok := defaultProblemOf(problem); ok {
+				dp.Type = t.URI
+
+				if t.Title != "" {
+					dp.Title = t.Title
+				}
+			}
+		}
+	}
+
+	return extendProblem(problem, extensions)
+}
+
 // NewProblemConverter returns a new ProblemConverter implementation populated with default problem matchers.
 func NewDefaultProblemConverter(opts ...ProblemConverterOption) ProblemConverter {
 	return NewProblemConverter(append(opts, WithProblemMatchers(DefaultProblemMatchers...))...)
@@ -2,12 +2,17 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/moogar0880/problems"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
 )
 
 func TestNewStatusProblemMatcher(t *testing.T) {
@@ -22,6 +27,21 @@ func TestNewStatusProblemMatcher(t *testing.T) {
 	}
 }
 
+func TestNewStatusProblemMatcher_Combinators(t *testing.T) {
+	matcher := NewStatusProblemMatcher(
+		http.StatusGatewayTimeout,
+		appkiterrors.MatchAny(appkiterrors.MatchIs(context.DeadlineExceeded), appkiterrors.IsTimeoutError),
+	)
+
+	if !matcher.MatchError(fmt.Errorf("call: %w", context.DeadlineExceeded)) {
+		t.Error("error is supposed to be matched")
+	}
+
+	if matcher.MatchError(context.Canceled) {
+		t.Error("error is NOT supposed to be matched")
+	}
+}
+
 type matcherStub struct {
 	err error
 }
@@ -176,6 +196,300 @@ func TestProblemConverter(t *testing.T) {
 	})
 }
 
+type errorCodeStub struct{}
+
+func (errorCodeStub) Error() string {
+	return "error"
+}
+
+func (errorCodeStub) ErrorCode() string {
+	return "USER_NOT_FOUND"
+}
+
+func TestProblemConverter_ErrorCode(t *testing.T) {
+	err := errorCodeStub{}
+
+	t.Run("extension", func(t *testing.T) {
+		problemConverter := NewProblemConverter(
+			WithProblemMatchers(statusMatcherStub{
+				err:    err,
+				status: http.StatusNotFound,
+			}),
+		)
+
+		problem := problemConverter.NewProblem(context.Background(), err).(*ExtendedProblem)
+
+		testProblemEquals(t, problem.Problem.(*problems.DefaultProblem), http.StatusNotFound, "error")
+
+		if want, have := "USER_NOT_FOUND", problem.Extensions["code"]; want != have {
+			t.Errorf("unexpected error code\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("type", func(t *testing.T) {
+		problemConverter := NewProblemConverter(
+			WithProblemMatchers(statusMatcherStub{
+				err:    err,
+				status: http.StatusNotFound,
+			}),
+			WithErrorCodeTypeURI("https://errors.example.com/"),
+		)
+
+		problem := problemConverter.NewProblem(context.Background(), err).(*ExtendedProblem)
+
+		if want, have := "https://errors.example.com/USER_NOT_FOUND", problem.Problem.(*problems.DefaultProblem).Type; want != have {
+			t.Errorf("unexpected type\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		problemConverter := NewProblemConverter()
+
+		problem := problemConverter.NewProblem(context.Background(), err).(*problems.DefaultProblem)
+
+		testProblemEquals(t, problem, http.StatusInternalServerError, "something went wrong")
+	})
+}
+
+type detailsStub struct{}
+
+func (detailsStub) Error() string {
+	return "error"
+}
+
+func (detailsStub) ErrorCode() string {
+	return "USER_NOT_FOUND"
+}
+
+func (detailsStub) Details() map[string]interface{} {
+	return map[string]interface{}{
+		"id":   1,
+		"code": "extension members are not supposed to override the error code",
+	}
+}
+
+func TestProblemConverter_Details(t *testing.T) {
+	err := detailsStub{}
+
+	problemConverter := NewProblemConverter(
+		WithProblemMatchers(statusMatcherStub{
+			err:    err,
+			status: http.StatusNotFound,
+		}),
+	)
+
+	problem := problemConverter.NewProblem(context.Background(), err)
+
+	body, jerr := json.Marshal(problem)
+	if jerr != nil {
+		t.Fatal(jerr)
+	}
+
+	want := `{"type":"about:blank","title":"Not Found","status":404,"detail":"error","code":"USER_NOT_FOUND","id":1}`
+
+	if have := string(body); want != have {
+		t.Errorf("unexpected JSON\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestProblemConverter_Instance(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	req.Header.Set("X-Request-Id", "1234")
+
+	ctx := PopulateRequestContext(context.Background(), req)
+
+	t.Run("matched", func(t *testing.T) {
+		problemConverter := NewDefaultProblemConverter(WithProblemInstance(HeaderRequestIDExtractor("X-Request-Id")))
+
+		problem := problemConverter.NewProblem(ctx, notFoundStub{}).(*problems.DefaultProblem)
+
+		if want, have := "/users/1?request_id=1234", problem.Instance; want != have {
+			t.Errorf("unexpected instance\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		problemConverter := NewDefaultProblemConverter(WithProblemInstance(HeaderRequestIDExtractor("X-Request-Id")))
+
+		problem := problemConverter.NewProblem(ctx, errors.New("error")).(*problems.DefaultProblem)
+
+		if want, have := "/users/1?request_id=1234", problem.Instance; want != have {
+			t.Errorf("unexpected instance\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("path", func(t *testing.T) {
+		problemConverter := NewDefaultProblemConverter(WithProblemInstance(nil))
+
+		problem := problemConverter.NewProblem(ctx, notFoundStub{}).(*problems.DefaultProblem)
+
+		if want, have := "/users/1", problem.Instance; want != have {
+			t.Errorf("unexpected instance\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		problemConverter := NewDefaultProblemConverter()
+
+		problem := problemConverter.NewProblem(ctx, notFoundStub{}).(*problems.DefaultProblem)
+
+		if want, have := "", problem.Instance; want != have {
+			t.Errorf("unexpected instance\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+}
+
+func TestProblemConverter_Redaction(t *testing.T) {
+	err := appkiterrors.WithNotFound(fmt.Errorf("user not found: %w", errors.New("select * from users: connection refused")))
+
+	tests := []struct {
+		name     string
+		options  []ProblemConverterOption
+		err      error
+		expected string
+	}{
+		{
+			name:     "Disabled",
+			err:      err,
+			expected: err.Error(),
+		},
+		{
+			name:     "OuterMessage",
+			options:  []ProblemConverterOption{WithRedaction(appkiterrors.OuterMessage)},
+			err:      err,
+			expected: "user not found",
+		},
+		{
+			name:     "PublicMessage",
+			options:  []ProblemConverterOption{WithRedaction(appkiterrors.PublicMessageRedactor(nil))},
+			err:      appkiterrors.WithPublicMessage(err, "the user does not exist"),
+			expected: "the user does not exist",
+		},
+		{
+			name:     "FullMessage",
+			options:  []ProblemConverterOption{WithRedaction(appkiterrors.FullMessage)},
+			err:      err,
+			expected: err.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			problem := NewDefaultProblemConverter(test.options...).NewProblem(context.Background(), test.err).(*problems.DefaultProblem)
+
+			if want, have := test.expected, problem.Detail; want != have {
+				t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestProblemConverter_ContextErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		options        []ProblemConverterOption
+		err            error
+		expectedStatus int
+		expectedTitle  string
+	}{
+		{
+			name:           "Canceled",
+			err:            fmt.Errorf("call: %w", context.Canceled),
+			expectedStatus: 499,
+			expectedTitle:  "Client Closed Request",
+		},
+		{
+			name:           "DeadlineExceeded",
+			err:            fmt.Errorf("call: %w", context.DeadlineExceeded),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedTitle:  "Gateway Timeout",
+		},
+		{
+			name:           "Canceled/Excluded",
+			options:        []ProblemConverterOption{WithoutContextErrors()},
+			err:            context.Canceled,
+			expectedStatus: http.StatusInternalServerError,
+			expectedTitle:  "Internal Server Error",
+		},
+		{
+			name:           "DeadlineExceeded/Excluded",
+			options:        []ProblemConverterOption{WithoutContextErrors()},
+			err:            context.DeadlineExceeded,
+			expectedStatus: http.StatusInternalServerError,
+			expectedTitle:  "Internal Server Error",
+		},
+		{
+			name:           "Timeout/Excluded",
+			options:        []ProblemConverterOption{WithoutContextErrors()},
+			err:            appkiterrors.NewTimeout("timeout"),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedTitle:  "Gateway Timeout",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			problem := NewDefaultProblemConverter(test.options...).NewProblem(context.Background(), test.err).(*problems.DefaultProblem)
+
+			if want, have := test.expectedStatus, problem.Status; want != have {
+				t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+			}
+
+			if want, have := test.expectedTitle, problem.Title; want != have {
+				t.Errorf("unexpected title\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestProblemConverter_ContextErrors_Masked(t *testing.T) {
+	tests := []struct {
+		err            error
+		expectedStatus int
+		expectedDetail string
+	}{
+		{
+			err:            &url.Error{Op: "Get", URL: "http://billing.internal:8080/secret", Err: context.Canceled},
+			expectedStatus: 499,
+			expectedDetail: "request canceled",
+		},
+		{
+			err:            fmt.Errorf("call billing: %w", &url.Error{Op: "Get", URL: "http://billing.internal:8080/secret", Err: context.DeadlineExceeded}),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedDetail: "deadline exceeded",
+		},
+	}
+
+	converters := map[string]ProblemConverter{
+		"default":   NewDefaultProblemConverter(),
+		"redaction": NewDefaultProblemConverter(WithRedaction(appkiterrors.OuterMessage)),
+	}
+
+	for name, converter := range converters {
+		converter := converter
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				problem := converter.NewProblem(context.Background(), test.err).(*problems.DefaultProblem)
+
+				if want, have := test.expectedStatus, problem.Status; want != have {
+					t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+				}
+
+				if want, have := test.expectedDetail, problem.Detail; want != have {
+					t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+				}
+			})
+		}
+	}
+}
+
 func ExampleNewProblemConverter() {
 	problemConverter := NewProblemConverter(
 		WithProblemMatchers(
@@ -0,0 +1,271 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/moogar0880/problems"
+)
+
+// NewErrorEncoder returns an error encoder (compatible with go-kit's ErrorEncoder)
+// that converts errors to problems using a ProblemConverter and writes them to the response.
+//
+// If the context contains the request (see PopulateRequestContext),
+// the problem is encoded based on the Accept header of the request (see NegotiateProblemEncoder).
+// Otherwise the problem is encoded as JSON.
+//
+// See EncodeProblem for details about how problems are written to the response.
+func NewErrorEncoder(converter ProblemConverter) func(ctx context.Context, err error, w http.ResponseWriter) {
+	return func(ctx context.Context, err error, w http.ResponseWriter) {
+		var accept string
+
+		if r, ok := requestFromContext(ctx); ok {
+			accept = r.Header.Get("Accept")
+		}
+
+		_ = NegotiateProblemEncoder(accept)(w, converter.NewProblem(ctx, err))
+	}
+}
+
+// ProblemEncoder writes a problem to the response.
+type ProblemEncoder func(w http.ResponseWriter, problem interface{}) error
+
+// NegotiateProblemEncoder returns a ProblemEncoder based on the value of an Accept header.
+// It returns EncodeProblemXML if the client prefers XML
+// ("application/problem+xml", "application/xml" or "text/xml") and EncodeProblem otherwise
+// (including when both formats are equally acceptable).
+func NegotiateProblemEncoder(accept string) ProblemEncoder {
+	var jsonQuality, xmlQuality float64
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		switch mediaType {
+		case problems.ProblemMediaType, "application/json", "application/*", "*/*":
+			jsonQuality = maxFloat(jsonQuality, quality)
+
+		case problems.ProblemMediaTypeXML, "application/xml", "text/xml":
+			xmlQuality = maxFloat(xmlQuality, quality)
+		}
+	}
+
+	if xmlQuality > jsonQuality {
+		return EncodeProblemXML
+	}
+
+	return EncodeProblem
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// EncodeProblem writes a problem to the response as "application/problem+json".
+//
+// The status code of the response is taken from the problem if it implements StatusProblem,
+// otherwise it defaults to HTTP 500.
+//
+// If the problem implements the following interface (compatible with go-kit's Headerer),
+// the returned headers are added to the response:
+//
+//	type headerer interface {
+//		Headers() http.Header
+//	}
+func EncodeProblem(w http.ResponseWriter, problem interface{}) error {
+	writeProblemHeader(w, problem, problems.ProblemMediaType)
+
+	return json.NewEncoder(w).Encode(problem)
+}
+
+// EncodeProblemXML writes a problem to the response as "application/problem+xml".
+//
+// The XML representation follows Appendix A of RFC-7807:
+// the problem is converted to its JSON representation first,
+// then every member becomes an element (array items become "i" elements).
+// Members whose names are not valid XML element names (eg. "first name" or "items[0]")
+// become "i" elements with a "name" attribute holding the member name.
+//
+// See EncodeProblem for details about the status code and the headers of the response.
+func EncodeProblemXML(w http.ResponseWriter, problem interface{}) error {
+	body, err := marshalProblemXML(problem)
+	if err != nil {
+		return err
+	}
+
+	writeProblemHeader(w, problem, problems.ProblemMediaTypeXML)
+
+	_, err = w.Write(body)
+
+	return err
+}
+
+func writeProblemHeader(w http.ResponseWriter, problem interface{}, contentType string) {
+	if headerer, ok := problem.(interface{ Headers() http.Header }); ok {
+		for key, values := range headerer.Headers() {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+	}
+
+	status := http.StatusInternalServerError
+	if sp, ok := problem.(StatusProblem); ok {
+		status = sp.ProblemStatus()
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+}
+
+// marshalProblemXML returns the RFC-7807 XML representation of a problem.
+func marshalProblemXML(problem interface{}) ([]byte, error) {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+
+	root := xml.StartElement{Name: xml.Name{Space: "urn:ietf:rfc:7807", Local: "problem"}}
+
+	if err := encodeXMLElement(dec, enc, root); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeXMLElement encodes the next JSON value from the decoder as an XML element.
+func encodeXMLElement(dec *json.Decoder, enc *xml.Encoder, start xml.StartElement) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		for dec.More() {
+			child := xml.StartElement{Name: xml.Name{Local: "i"}}
+
+			if t == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+
+				child = xmlMemberElement(fmt.Sprint(key))
+			}
+
+			if err := encodeXMLElement(dec, enc, child); err != nil {
+				return err
+			}
+		}
+
+		// Consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+
+	case nil:
+
+	default:
+		if err := enc.EncodeToken(xml.CharData(fmt.Sprint(t))); err != nil {
+			return err
+		}
+	}
+
+	return enc.EncodeToken(start.End())
+}
+
+// xmlMemberElement returns the start element of an object member.
+func xmlMemberElement(name string) xml.StartElement {
+	if isXMLName(name) {
+		return xml.StartElement{Name: xml.Name{Local: name}}
+	}
+
+	return xml.StartElement{
+		Name: xml.Name{Local: "i"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "name"}, Value: name}},
+	}
+}
+
+// isXMLName checks if a string is a valid (non-namespaced) XML element name.
+// Names starting with "xml" (reserved by the XML specification) are not accepted.
+func isXMLName(name string) bool {
+	if name == "" || strings.HasPrefix(strings.ToLower(name), "xml") {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+		case i > 0 && (unicode.IsDigit(r) || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// HandlerFunc is an HTTP handler function that may return an error.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ProblemMiddleware returns a middleware that turns a HandlerFunc into an http.Handler.
+// Errors returned by the handler are converted to problems using a ProblemConverter and written to the response
+// (see NewErrorEncoder).
+//
+// The request is stored in the context passed to the handler (see PopulateRequestContext).
+//
+// Handlers should not write to the response before returning an error.
+func ProblemMiddleware(converter ProblemConverter) func(next HandlerFunc) http.Handler {
+	errorEncoder := NewErrorEncoder(converter)
+
+	return func(next HandlerFunc) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(PopulateRequestContext(r.Context(), r))
+
+			if err := next(w, r); err != nil {
+				errorEncoder(r.Context(), err, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moogar0880/problems"
+)
+
+func TestErrorEncoder(t *testing.T) {
+	errorEncoder := NewErrorEncoder(NewDefaultProblemConverter())
+
+	rec := httptest.NewRecorder()
+
+	errorEncoder(context.Background(), notFoundStub{}, rec)
+
+	if want, have := http.StatusNotFound, rec.Code; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := problems.ProblemMediaType, rec.Header().Get("Content-Type"); want != have {
+		t.Errorf("unexpected content type\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	var problem problems.DefaultProblem
+
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatal(err)
+	}
+
+	testProblemEquals(t, &problem, http.StatusNotFound, "not found")
+}
+
+func TestEncodeProblem_Headers(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	err := EncodeProblem(rec, NewTooManyRequestsProblem("too many requests", time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := http.StatusTooManyRequests, rec.Code; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := "60", rec.Header().Get("Retry-After"); want != have {
+		t.Errorf("unexpected Retry-After header\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestProblemMiddleware(t *testing.T) {
+	middleware := ProblemMiddleware(NewDefaultProblemConverter())
+
+	t.Run("error", func(t *testing.T) {
+		handler := middleware(func(w http.ResponseWriter, r *http.Request) error {
+			return errors.New("error")
+		})
+
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if want, have := http.StatusInternalServerError, rec.Code; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		resp := rec.Result()
+		defer resp.Body.Close()
+
+		if err := DecodeProblem(resp); err == nil || err.Error() != "something went wrong" {
+			t.Errorf("unexpected problem: %v", err)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		handler := middleware(func(w http.ResponseWriter, r *http.Request) error {
+			w.WriteHeader(http.StatusNoContent)
+
+			return nil
+		})
+
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if want, have := http.StatusNoContent, rec.Code; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+}
+
+func TestNegotiateProblemEncoder(t *testing.T) {
+	tests := []struct {
+		accept      string
+		contentType string
+	}{
+		{
+			accept:      "",
+			contentType: problems.ProblemMediaType,
+		},
+		{
+			accept:      "*/*",
+			contentType: problems.ProblemMediaType,
+		},
+		{
+			accept:      "application/problem+json",
+			contentType: problems.ProblemMediaType,
+		},
+		{
+			accept:      "application/problem+xml",
+			contentType: problems.ProblemMediaTypeXML,
+		},
+		{
+			accept:      "text/html, application/xml;q=0.9, */*;q=0.8",
+			contentType: problems.ProblemMediaTypeXML,
+		},
+		{
+			accept:      "application/problem+xml;q=0.5, application/problem+json",
+			contentType: problems.ProblemMediaType,
+		},
+		{
+			accept:      "application/problem+xml, application/problem+json",
+			contentType: problems.ProblemMediaType,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.accept, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+
+			err := NegotiateProblemEncoder(test.accept)(rec, problems.NewDetailedProblem(http.StatusNotFound, "not found"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want, have := test.contentType, rec.Header().Get("Content-Type"); want != have {
+				t.Errorf("unexpected content type\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestEncodeProblemXML(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		err := EncodeProblemXML(rec, problems.NewDetailedProblem(http.StatusNotFound, "not found"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want, have := http.StatusNotFound, rec.Code; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+			`<problem xmlns="urn:ietf:rfc:7807"><type>about:blank</type><title>Not Found</title><status>404</status><detail>not found</detail></problem>`
+
+		if have := rec.Body.String(); want != have {
+			t.Errorf("unexpected XML\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		err := EncodeProblemXML(rec, NewValidationProblem("invalid", map[string][]string{
+			"email":      {"required", "invalid"},
+			"first name": {"required"},
+			"items[0]":   {"invalid"},
+			"1st":        {"invalid"},
+			"xmlns":      {"invalid"},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+			`<problem xmlns="urn:ietf:rfc:7807"><type>about:blank</type><title>Unprocessable Entity</title><status>422</status><detail>invalid</detail>` +
+			`<violations>` +
+			`<i name="1st"><i>invalid</i></i>` +
+			`<email><i>required</i><i>invalid</i></email>` +
+			`<i name="first name"><i>required</i></i>` +
+			`<i name="items[0]"><i>invalid</i></i>` +
+			`<i name="xmlns"><i>invalid</i></i>` +
+			`</violations></problem>`
+
+		if have := rec.Body.String(); want != have {
+			t.Errorf("unexpected XML\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		dec := xml.NewDecoder(strings.NewReader(rec.Body.String()))
+
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("invalid XML: %v", err)
+			}
+		}
+	})
+}
+
+func TestProblemMiddleware_XML(t *testing.T) {
+	handler := ProblemMiddleware(NewDefaultProblemConverter())(func(w http.ResponseWriter, r *http.Request) error {
+		return notFoundStub{}
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", problems.ProblemMediaTypeXML)
+
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if want, have := problems.ProblemMediaTypeXML, rec.Header().Get("Content-Type"); want != have {
+		t.Errorf("unexpected content type\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	var problem struct {
+		Status int    `xml:"status"`
+		Detail string `xml:"detail"`
+	}
+
+	if err := xml.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := http.StatusNotFound, problem.Status; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := "not found", problem.Detail; want != have {
+		t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+	}
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moogar0880/problems"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// ProblemError is an error decoded from an RFC-7807 problem.
+//
+// ProblemError implements the error behaviors from the errors package based on the status code of the problem,
+// so that errors returned by remote services can be inspected the same way as local ones
+// (eg. errors.IsNotFoundError).
+type ProblemError struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+
+	// Extensions contains the extension members of the problem.
+	Extensions map[string]interface{}
+
+	fieldViolations []appkiterrors.FieldViolation
+	retryAfter      time.Duration
+}
+
+// DecodeProblem decodes an RFC-7807 problem from an HTTP response into a ProblemError.
+// It returns nil if the response does not indicate an error (ie. the status code is less than 400).
+//
+// If the response is not an "application/problem+json" response (or it cannot be decoded),
+// the returned error is populated from the status code of the response.
+//
+// DecodeProblem reads, but does not close the response body.
+func DecodeProblem(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != problems.ProblemMediaType {
+		return newStatusProblemError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return newStatusProblemError(resp)
+	}
+
+	var members map[string]json.RawMessage
+
+	if err := json.Unmarshal(body, &members); err != nil {
+		return newStatusProblemError(resp)
+	}
+
+	perr := newStatusProblemError(resp)
+
+	for key, value := range members {
+		var err error
+
+		switch key {
+		case "type":
+			err = json.Unmarshal(value, &perr.Type)
+		case "title":
+			err = json.Unmarshal(value, &perr.Title)
+		case "status":
+			err = json.Unmarshal(value, &perr.Status)
+		case "detail":
+			err = json.Unmarshal(value, &perr.Detail)
+		case "instance":
+			err = json.Unmarshal(value, &perr.Instance)
+		case "violations":
+			err = perr.decodeViolations(value)
+		case "errors":
+			if perr.decodeValidationErrors(value) == nil {
+				break
+			}
+
+			fallthrough
+		default:
+			var v interface{}
+
+			err = json.Unmarshal(value, &v)
+
+			if perr.Extensions == nil {
+				perr.Extensions = make(map[string]interface{})
+			}
+
+			perr.Extensions[key] = v
+		}
+
+		if err != nil {
+			return newStatusProblemError(resp)
+		}
+	}
+
+	return perr
+}
+
+// decodeViolations decodes a "violations" member into violations (see ValidationProblem).
+// Violations are ordered by field name.
+func (e *ProblemError) decodeViolations(value json.RawMessage) error {
+	var violations map[string][]string
+
+	if err := json.Unmarshal(value, &violations); err != nil {
+		return err
+	}
+
+	e.fieldViolations = append(e.fieldViolations, appkiterrors.FieldViolationsFromMap(violations)...)
+
+	return nil
+}
+
+// decodeValidationErrors decodes an RFC-9457 "errors" array into violations (see ValidationErrorsProblem),
+// preserving their order, code and parameters.
+func (e *ProblemError) decodeValidationErrors(value json.RawMessage) error {
+	var errs []ValidationErrorDetail
+
+	if err := json.Unmarshal(value, &errs); err != nil {
+		return err
+	}
+
+	for _, verr := range errs {
+		e.fieldViolations = append(e.fieldViolations, appkiterrors.FieldViolation{
+			Path:    jsonPointerPath(verr.Pointer),
+			Code:    verr.Code,
+			Message: verr.Detail,
+			Params:  verr.Params,
+		})
+	}
+
+	return nil
+}
+
+// jsonPointerPath returns the path of a field referenced by a JSON Pointer.
+func jsonPointerPath(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	path := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+
+	for i, segment := range path {
+		path[i] = jsonPointerUnescaper.Replace(segment)
+	}
+
+	return path
+}
+
+// jsonPointerUnescaper unescapes a JSON Pointer reference token according to RFC-6901.
+// nolint: gochecknoglobals
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// newStatusProblemError returns a ProblemError populated from the status code and headers of a response.
+func newStatusProblemError(resp *http.Response) *ProblemError {
+	perr := &ProblemError{
+		Type:   problems.DefaultURL,
+		Title:  http.StatusText(resp.StatusCode),
+		Status: resp.StatusCode,
+	}
+
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		perr.retryAfter = retryAfter
+	}
+
+	return perr
+}
+
+// parseRetryAfter parses the value of a Retry-After header (either delay seconds or an HTTP date).
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, seconds > 0
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := time.Until(date)
+
+		return d, d > 0
+	}
+
+	return 0, false
+}
+
+func (e *ProblemError) Error() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+
+	return e.Title
+}
+
+// ProblemStatus returns the status code of the problem.
+func (e *ProblemError) ProblemStatus() int {
+	return e.Status
+}
+
+// NotFound implements the NotFound error behavior.
+func (e *ProblemError) NotFound() bool {
+	return e.Status == http.StatusNotFound
+}
+
+// Validation implements the Validation error behavior.
+func (e *ProblemError) Validation() bool {
+	return e.Status == http.StatusUnprocessableEntity
+}
+
+// Violations returns the validation violations of the problem (if any) grouped by field.
+func (e *ProblemError) Violations() map[string][]string {
+	if e.fieldViolations == nil {
+		return nil
+	}
+
+	return appkiterrors.FieldViolationsToMap(e.fieldViolations)
+}
+
+// FieldViolations returns the ordered list of validation violations of the problem (if any).
+func (e *ProblemError) FieldViolations() []appkiterrors.FieldViolation {
+	return e.fieldViolations
+}
+
+// BadRequest implements the BadRequest error behavior.
+func (e *ProblemError) BadRequest() bool {
+	return e.Status == http.StatusBadRequest
+}
+
+// Conflict implements the Conflict error behavior.
+func (e *ProblemError) Conflict() bool {
+	return e.Status == http.StatusConflict
+}
+
+// Unauthenticated implements the Unauthenticated error behavior.
+func (e *ProblemError) Unauthenticated() bool {
+	return e.Status == http.StatusUnauthorized
+}
+
+// PermissionDenied implements the PermissionDenied error behavior.
+func (e *ProblemError) PermissionDenied() bool {
+	return e.Status == http.StatusForbidden
+}
+
+// TooManyRequests implements the TooManyRequests error behavior.
+func (e *ProblemError) TooManyRequests() bool {
+	return e.Status == http.StatusTooManyRequests
+}
+
+// RetryAfter returns the duration after which the request may be retried (taken from the Retry-After header).
+func (e *ProblemError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Unavailable implements the Unavailable error behavior.
+func (e *ProblemError) Unavailable() bool {
+	return e.Status == http.StatusServiceUnavailable
+}
+
+// TimeoutError implements the Timeout error behavior.
+func (e *ProblemError) TimeoutError() bool {
+	return e.Status == http.StatusGatewayTimeout
+}
+
+// PreconditionFailed implements the PreconditionFailed error behavior.
+func (e *ProblemError) PreconditionFailed() bool {
+	return e.Status == http.StatusPreconditionFailed
+}
+
+// NotImplemented implements the NotImplemented error behavior.
+func (e *ProblemError) NotImplemented() bool {
+	return e.Status == http.StatusNotImplemented
+}
+
+// ErrorCode returns the error code of the problem (taken from the "code" extension member).
+func (e *ProblemError) ErrorCode() string {
+	code, _ := e.Extensions["code"].(string)
+
+	return code
+}
+
+// Details returns the extension members of the problem (except the error code).
+func (e *ProblemError) Details() map[string]interface{} {
+	details := make(map[string]interface{}, len(e.Extensions))
+
+	for key, value := range e.Extensions {
+		if key == "code" {
+			continue
+		}
+
+		details[key] = value
+	}
+
+	return details
+}
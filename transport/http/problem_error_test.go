@@ -0,0 +1,240 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/moogar0880/problems"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func newProblemResponse(t *testing.T, problem interface{}, status int) *http.Response {
+	t.Helper()
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", problems.ProblemMediaType)
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+func TestDecodeProblem(t *testing.T) {
+	tests := []struct {
+		status  int
+		matcher func(err error) bool
+	}{
+		{
+			status:  http.StatusNotFound,
+			matcher: appkiterrors.IsNotFoundError,
+		},
+		{
+			status:  http.StatusUnprocessableEntity,
+			matcher: appkiterrors.IsValidationError,
+		},
+		{
+			status:  http.StatusBadRequest,
+			matcher: appkiterrors.IsBadRequestError,
+		},
+		{
+			status:  http.StatusConflict,
+			matcher: appkiterrors.IsConflictError,
+		},
+		{
+			status:  http.StatusUnauthorized,
+			matcher: appkiterrors.IsUnauthenticatedError,
+		},
+		{
+			status:  http.StatusForbidden,
+			matcher: appkiterrors.IsPermissionDeniedError,
+		},
+		{
+			status:  http.StatusTooManyRequests,
+			matcher: appkiterrors.IsTooManyRequestsError,
+		},
+		{
+			status:  http.StatusServiceUnavailable,
+			matcher: appkiterrors.IsUnavailableError,
+		},
+		{
+			status:  http.StatusGatewayTimeout,
+			matcher: appkiterrors.IsTimeoutError,
+		},
+		{
+			status:  http.StatusPreconditionFailed,
+			matcher: appkiterrors.IsPreconditionFailedError,
+		},
+		{
+			status:  http.StatusNotImplemented,
+			matcher: appkiterrors.IsNotImplementedError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(http.StatusText(test.status), func(t *testing.T) {
+			resp := newProblemResponse(t, problems.NewDetailedProblem(test.status, "error"), test.status)
+
+			err := DecodeProblem(resp)
+
+			if !test.matcher(err) {
+				t.Error("error is supposed to implement the matching behavior")
+			}
+
+			if want, have := "error", err.Error(); want != have {
+				t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+			}
+		})
+	}
+}
+
+func TestDecodeProblem_Validation(t *testing.T) {
+	resp := newProblemResponse(
+		t,
+		NewValidationProblem("invalid", map[string][]string{"email": {"required"}}),
+		http.StatusUnprocessableEntity,
+	)
+
+	err := DecodeProblem(resp)
+
+	violations, ok := appkiterrors.Violations(err)
+
+	if !appkiterrors.IsValidationError(err) || !ok {
+		t.Fatal("error is supposed to be a validation error with violations")
+	}
+
+	if want, have := "required", violations["email"][0]; want != have {
+		t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestDecodeProblem_Extensions(t *testing.T) {
+	resp := newProblemResponse(
+		t,
+		extendProblem(
+			problems.NewDetailedProblem(http.StatusNotFound, "not found"),
+			map[string]interface{}{"code": "USER_NOT_FOUND", "id": "1"},
+		),
+		http.StatusNotFound,
+	)
+
+	err := DecodeProblem(resp)
+
+	code, _ := appkiterrors.ErrorCode(err)
+
+	if want, have := "USER_NOT_FOUND", code; want != have {
+		t.Errorf("unexpected error code\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	details, _ := appkiterrors.Details(err)
+
+	if want, have := "1", details["id"]; want != have {
+		t.Errorf("unexpected detail\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestDecodeProblem_RetryAfter(t *testing.T) {
+	resp := newProblemResponse(
+		t,
+		NewTooManyRequestsProblem("too many requests", time.Minute),
+		http.StatusTooManyRequests,
+	)
+	resp.Header.Set("Retry-After", "60")
+
+	err := DecodeProblem(resp)
+
+	retryAfter, ok := appkiterrors.RetryAfter(err)
+	if !ok {
+		t.Fatal("error is supposed to carry retry information")
+	}
+
+	if want, have := time.Minute, retryAfter; want != have {
+		t.Errorf("unexpected duration\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestDecodeProblem_NonProblem(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader([]byte("404 page not found"))),
+	}
+
+	err := DecodeProblem(resp)
+
+	if !appkiterrors.IsNotFoundError(err) {
+		t.Error("error is supposed to be a NotFound error")
+	}
+
+	if want, have := "Not Found", err.Error(); want != have {
+		t.Errorf("unexpected message\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestDecodeProblem_Success(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+	}
+
+	if err := DecodeProblem(resp); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDecodeProblem_ValidationErrors(t *testing.T) {
+	resp := newProblemResponse(
+		t,
+		NewValidationErrorsProblem("invalid", map[string][]string{"a/b": {"required", "invalid"}}),
+		http.StatusUnprocessableEntity,
+	)
+
+	err := DecodeProblem(resp)
+
+	violations, ok := appkiterrors.Violations(err)
+	if !ok {
+		t.Fatal("error is supposed to carry violations")
+	}
+
+	if want, have := []string{"required", "invalid"}, violations["a/b"]; len(have) != 2 || want[0] != have[0] || want[1] != have[1] {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestDecodeProblem_FieldViolations(t *testing.T) {
+	expected := []appkiterrors.FieldViolation{
+		{Path: []string{"name"}, Code: "too_short", Message: "name is too short", Params: map[string]interface{}{"min": 3.0}},
+		{Path: []string{"addresses", "0", "city/town"}, Code: "required", Message: "city is required"},
+		{Path: []string{"email"}, Code: "email", Message: "invalid email"},
+	}
+
+	resp := newProblemResponse(
+		t,
+		NewFieldValidationErrorsProblem("invalid", expected),
+		http.StatusUnprocessableEntity,
+	)
+
+	violations, ok := appkiterrors.FieldViolations(DecodeProblem(resp))
+	if !ok {
+		t.Fatal("error is supposed to carry field violations")
+	}
+
+	if !reflect.DeepEqual(expected, violations) {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", expected, violations)
+	}
+}
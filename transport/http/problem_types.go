@@ -0,0 +1,145 @@
+package http
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sagikazarmark/appkit/transport/classification"
+)
+
+// ProblemType describes a class of problems.
+//
+// See details at https://tools.ietf.org/html/rfc7807#section-4
+type ProblemType struct {
+	// URI identifies the problem type (eg. "https://errors.example.com/not-found").
+	// Ideally it yields human-readable documentation when dereferenced.
+	URI string `json:"type"`
+
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title"`
+
+	// Status is the HTTP status code usually associated with the problem type.
+	Status int `json:"status,omitempty"`
+
+	// Description is a human-readable documentation of the problem type.
+	Description string `json:"description,omitempty"`
+
+	// Matcher selects errors belonging to the problem type.
+	Matcher ErrorMatcher `json:"-"`
+}
+
+// ProblemTypeRegistry is a list of registered problem types.
+type ProblemTypeRegistry struct {
+	mu    sync.RWMutex
+	types []ProblemType
+}
+
+// NewProblemTypeRegistry returns a new ProblemTypeRegistry.
+func NewProblemTypeRegistry(types ...ProblemType) *ProblemTypeRegistry {
+	return &ProblemTypeRegistry{
+		types: types,
+	}
+}
+
+// NewDefaultProblemTypeRegistry returns a new ProblemTypeRegistry
+// populated with a problem type for every error class in classification.DefaultClasses mapped to an HTTP status.
+// Problem type URIs are created by appending the name of the class (eg. "not-found") to the base URI.
+func NewDefaultProblemTypeRegistry(baseURI string) *ProblemTypeRegistry {
+	registry := NewProblemTypeRegistry()
+
+	for _, class := range classification.DefaultClasses {
+		if class.HTTPStatus == 0 {
+			continue
+		}
+
+		registry.Register(ProblemType{
+			URI:     baseURI + class.Name,
+			Title:   statusText(class.HTTPStatus),
+			Status:  class.HTTPStatus,
+			Matcher: class.Matcher,
+		})
+	}
+
+	return registry
+}
+
+// Register adds problem types to the registry.
+func (r *ProblemTypeRegistry) Register(types ...ProblemType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.types = append(r.types, types...)
+}
+
+// Types returns the list of registered problem types.
+func (r *ProblemTypeRegistry) Types() []ProblemType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]ProblemType(nil), r.types...)
+}
+
+// Match returns the first registered problem type matching an error.
+func (r *ProblemTypeRegistry) Match(err error) (ProblemType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.types {
+		if t.Matcher != nil && t.Matcher(err) {
+			return t, true
+		}
+	}
+
+	return ProblemType{}, false
+}
+
+// problemTypesTemplate renders the HTML documentation of problem types.
+// nolint: gochecknoglobals
+var problemTypesTemplate = template.Must(template.New("").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Problem types</title>
+</head>
+<body>
+<h1>Problem types</h1>
+{{- range . }}
+<section>
+<h2>{{ .Title }}</h2>
+<dl>
+<dt>Type</dt><dd><code>{{ .URI }}</code></dd>
+{{- if .Status }}
+<dt>Status</dt><dd>{{ .Status }}</dd>
+{{- end }}
+</dl>
+{{- if .Description }}
+<p>{{ .Description }}</p>
+{{- end }}
+</section>
+{{- end }}
+</body>
+</html>
+`))
+
+// NewProblemTypeHandler returns an HTTP handler serving the documentation of registered problem types.
+// The documentation is rendered as HTML if the client accepts "text/html", otherwise as a JSON list.
+func NewProblemTypeHandler(registry *ProblemTypeRegistry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		types := registry.Types()
+
+		if strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+			_ = problemTypesTemplate.Execute(w, types)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(types)
+	})
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moogar0880/problems"
+)
+
+func TestProblemTypeRegistry_Match(t *testing.T) {
+	registry := NewDefaultProblemTypeRegistry("https://errors.example.com/")
+
+	problemType, ok := registry.Match(notFoundStub{})
+	if !ok {
+		t.Fatal("error is supposed to match a problem type")
+	}
+
+	if want, have := "https://errors.example.com/not-found", problemType.URI; want != have {
+		t.Errorf("unexpected type\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if _, ok := registry.Match(errors.New("error")); ok {
+		t.Error("error is NOT supposed to match a problem type")
+	}
+}
+
+func TestWithProblemTypes(t *testing.T) {
+	registry := NewDefaultProblemTypeRegistry("https://errors.example.com/")
+	registry.Register(ProblemType{
+		URI:     "https://errors.example.com/teapot",
+		Title:   "I'm a teapot",
+		Matcher: func(err error) bool { return err.Error() == "teapot" },
+	})
+
+	converter := NewDefaultProblemConverter(WithProblemTypes(registry))
+
+	problem := converter.NewProblem(context.Background(), notFoundStub{}).(*problems.DefaultProblem)
+
+	if want, have := "https://errors.example.com/not-found", problem.Type; want != have {
+		t.Errorf("unexpected type\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "Not Found", problem.Title; want != have {
+		t.Errorf("unexpected title\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestProblemTypeHandler(t *testing.T) {
+	registry := NewProblemTypeRegistry(ProblemType{
+		URI:         "https://errors.example.com/not-found",
+		Title:       "Not Found",
+		Status:      http.StatusNotFound,
+		Description: "The requested resource does not exist.",
+	})
+
+	handler := NewProblemTypeHandler(registry)
+
+	t.Run("json", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var types []ProblemType
+
+		if err := json.NewDecoder(rec.Body).Decode(&types); err != nil {
+			t.Fatal(err)
+		}
+
+		if want, have := "https://errors.example.com/not-found", types[0].URI; want != have {
+			t.Errorf("unexpected type\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("html", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		if want, have := "text/html; charset=utf-8", rec.Header().Get("Content-Type"); want != have {
+			t.Errorf("unexpected content type\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if !strings.Contains(rec.Body.String(), "The requested resource does not exist.") {
+			t.Error("documentation is supposed to contain the description of the problem type")
+		}
+	})
+}
@@ -1,17 +1,112 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
-	"github.com/sagikazarmark/appkit/errors"
+	"github.com/moogar0880/problems"
+
+	"github.com/sagikazarmark/appkit/transport/classification"
 )
 
-// DefaultProblemMatchers is a list of default ProblemMatchers.
+// DefaultProblemMatchers is a list of default ProblemMatchers derived from classification.DefaultClasses.
+//
+// Matchers are evaluated in order and the first matching one wins,
+// which defines the precedence for errors with multiple behaviors (eg. joined errors).
 // nolint: gochecknoglobals
-var DefaultProblemMatchers = []ProblemMatcher{
-	NewStatusProblemMatcher(http.StatusNotFound, errors.IsNotFoundError),
-	NewValidationWithViolationsProblemMatcher(),
-	NewStatusProblemMatcher(http.StatusUnprocessableEntity, errors.IsValidationError),
-	NewStatusProblemMatcher(http.StatusBadRequest, errors.IsBadRequestError),
-	NewStatusProblemMatcher(http.StatusConflict, errors.IsConflictError),
+var DefaultProblemMatchers = NewProblemMatchers(classification.DefaultClasses)
+
+// NewProblemMatchers returns a list of ProblemMatchers derived from a list of error classes (preserving their order).
+// Classes not mapped to an HTTP status are skipped.
+//
+// Problems of Validation and BadRequest classes carry violations (see NewValidationWithViolationsProblemMatcher)
+// and problems of the TooManyRequests class carry retry information (see NewTooManyRequestsProblemMatcher).
+// Just like any other class, they are matched using the matcher and the status of the class.
+//
+// Matchers derived from context error classes (see classification.Canceled and classification.DeadlineExceeded)
+// can be excluded from a ProblemConverter using WithoutContextErrors.
+func NewProblemMatchers(classes []classification.Class) []ProblemMatcher {
+	matchers := make([]ProblemMatcher, 0, len(classes))
+
+	for _, class := range classes {
+		if class.HTTPStatus == 0 {
+			continue
+		}
+
+		switch class.Name {
+		case classification.Validation, classification.BadRequest:
+			matchers = append(
+				matchers,
+				newValidationWithViolationsProblemMatcher(class.Matcher, class.HTTPStatus),
+				NewStatusProblemMatcher(class.HTTPStatus, class.Matcher),
+			)
+
+		case classification.TooManyRequests:
+			matchers = append(matchers, newTooManyRequestsProblemMatcher(class.Matcher, class.HTTPStatus))
+
+		case classification.Canceled, classification.DeadlineExceeded:
+			matchers = append(matchers, contextErrorProblemMatcher{
+				StatusProblemMatcher: NewStatusProblemMatcher(class.HTTPStatus, class.Matcher),
+				message:              contextErrorMessage(class.Name),
+			})
+
+		default:
+			matchers = append(matchers, NewStatusProblemMatcher(class.HTTPStatus, class.Matcher))
+		}
+	}
+
+	return matchers
+}
+
+// contextErrorProblemMatcher marks problem matchers of context errors (see WithoutContextErrors).
+//
+// Context errors are often wrapped by errors carrying internal details (eg. *url.Error containing the URL of a request),
+// so problems are created with a fixed detail.
+type contextErrorProblemMatcher struct {
+	StatusProblemMatcher
+
+	message string
+}
+
+func (m contextErrorProblemMatcher) NewProblem(_ context.Context, _ error) interface{} {
+	problem := problems.NewDetailedProblem(m.Status(), m.message)
+	problem.Title = statusText(m.Status())
+
+	return problem
+}
+
+func (contextErrorProblemMatcher) unredacted() {}
+
+// unredactedProblemMatcher is implemented by problem matchers creating problems with a detail
+// that is safe to expose as is, so it is not redacted (see WithRedaction).
+type unredactedProblemMatcher interface {
+	unredacted()
+}
+
+// contextErrorMessage returns the fixed message of a context error class.
+func contextErrorMessage(name string) string {
+	if name == classification.Canceled {
+		return "request canceled"
+	}
+
+	return "deadline exceeded"
+}
+
+// setProblemStatus changes the status (and the matching title) of a problem.
+func setProblemStatus(problem *problems.DefaultProblem, status int) {
+	if problem.Status == status {
+		return
+	}
+
+	problem.Status = status
+	problem.Title = statusText(status)
+}
+
+// statusText returns a text for an HTTP status code, including non-standard status codes used by this package.
+func statusText(status int) string {
+	if status == classification.StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+
+	return http.StatusText(status)
 }
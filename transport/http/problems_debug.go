@@ -0,0 +1,34 @@
+package http
+
+import (
+	"fmt"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// problemDebugInfo is the "debug" extension member of problems (see WithDebug).
+type problemDebugInfo struct {
+	Chain      []problemDebugError `json:"chain"`
+	StackTrace []string            `json:"stackTrace,omitempty"`
+}
+
+// problemDebugError describes an error in the Unwrap chain.
+type problemDebugError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+func newProblemDebugInfo(err error) problemDebugInfo {
+	var info problemDebugInfo
+
+	for _, e := range appkiterrors.Chain(err) {
+		info.Chain = append(info.Chain, problemDebugError{
+			Type:    fmt.Sprintf("%T", e),
+			Message: e.Error(),
+		})
+	}
+
+	info.StackTrace, _ = appkiterrors.StackTrace(err)
+
+	return info
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestWithDebug(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", errors.Join(errors.New("first"), errors.New("second")))
+
+	t.Run("enabled", func(t *testing.T) {
+		problem := NewDefaultProblemConverter(WithDebug()).NewProblem(context.Background(), err)
+
+		body, jerr := json.Marshal(problem)
+		if jerr != nil {
+			t.Fatal(jerr)
+		}
+
+		want := `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"something went wrong",` +
+			`"debug":{"chain":[` +
+			`{"type":"*fmt.wrapError","message":"wrapped: first\nsecond"},` +
+			`{"type":"*errors.joinError","message":"first\nsecond"},` +
+			`{"type":"*errors.errorString","message":"first"},` +
+			`{"type":"*errors.errorString","message":"second"}` +
+			`]}}`
+
+		if have := string(body); want != have {
+			t.Errorf("unexpected JSON\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		problem := NewDefaultProblemConverter().NewProblem(context.Background(), err)
+
+		if _, ok := problem.(*ExtendedProblem); ok {
+			t.Error("problem is NOT supposed to contain debug information")
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/moogar0880/problems"
+)
+
+// ExtendedProblem decorates a problem with RFC-7807 extension members.
+//
+// Extension members are merged into the JSON representation of the decorated problem.
+// They never override members of the decorated problem.
+type ExtendedProblem struct {
+	Problem    interface{}
+	Extensions map[string]interface{}
+}
+
+// extendProblem adds extension members to a problem.
+// If the problem is already an ExtendedProblem, the extension members are merged.
+func extendProblem(problem interface{}, extensions map[string]interface{}) interface{} {
+	if len(extensions) == 0 {
+		return problem
+	}
+
+	ep, ok := problem.(*ExtendedProblem)
+	if !ok {
+		ep = &ExtendedProblem{
+			Problem:    problem,
+			Extensions: make(map[string]interface{}, len(extensions)),
+		}
+	}
+
+	for key, value := range extensions {
+		ep.Extensions[key] = value
+	}
+
+	return ep
+}
+
+// ProblemStatus returns the status code of the decorated problem.
+func (p *ExtendedProblem) ProblemStatus() int {
+	if sp, ok := p.Problem.(StatusProblem); ok {
+		return sp.ProblemStatus()
+	}
+
+	return http.StatusInternalServerError
+}
+
+// Headers returns the response headers of the decorated problem (if any).
+func (p *ExtendedProblem) Headers() http.Header {
+	if h, ok := p.Problem.(interface{ Headers() http.Header }); ok {
+		return h.Headers()
+	}
+
+	return make(http.Header)
+}
+
+func (p *ExtendedProblem) defaultProblem() *problems.DefaultProblem {
+	dp, _ := defaultProblemOf(p.Problem)
+
+	return dp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p *ExtendedProblem) MarshalJSON() ([]byte, error) {
+	body, err := json.Marshal(p.Problem)
+	if err != nil {
+		return nil, err
+	}
+
+	var members map[string]json.RawMessage
+
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(p.Extensions))
+	for key := range p.Extensions {
+		if _, ok := members[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(bytes.TrimSuffix(bytes.TrimSpace(body), []byte("}")))
+
+	for i, key := range keys {
+		if len(members) > 0 || i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(p.Extensions[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// defaultProblemer is implemented by problems built on top of a default problem.
+type defaultProblemer interface {
+	defaultProblem() *problems.DefaultProblem
+}
+
+// defaultProblemOf returns the underlying default problem of a problem created by this package.
+func defaultProblemOf(problem interface{}) (*problems.DefaultProblem, bool) {
+	switch p := problem.(type) {
+	case *problems.DefaultProblem:
+		return p, true
+
+	case defaultProblemer:
+		dp := p.defaultProblem()
+
+		return dp, dp != nil
+	}
+
+	return nil, false
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/moogar0880/problems"
+)
+
+func TestExtendedProblem_MarshalJSON(t *testing.T) {
+	problem := extendProblem(
+		problems.NewDetailedProblem(http.StatusNotFound, "not found"),
+		map[string]interface{}{
+			"code":   "USER_NOT_FOUND",
+			"detail": "extension members are not supposed to override problem members",
+		},
+	)
+
+	problem = extendProblem(problem, map[string]interface{}{
+		"id": 1,
+	})
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found","code":"USER_NOT_FOUND","id":1}`
+
+	if have := string(body); want != have {
+		t.Errorf("unexpected JSON\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestExtendedProblem_ProblemStatus(t *testing.T) {
+	problem := extendProblem(
+		problems.NewDetailedProblem(http.StatusNotFound, "not found"),
+		map[string]interface{}{"code": "USER_NOT_FOUND"},
+	).(*ExtendedProblem)
+
+	if want, have := http.StatusNotFound, problem.ProblemStatus(); want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"context"
+
+	"github.com/sagikazarmark/appkit/i18n"
+)
+
+// problemLocalizer returns a localizer for the locales accepted by the client
+// (based on the Accept-Language header of the request stored in the context, see PopulateRequestContext).
+func problemLocalizer(ctx context.Context, catalog i18n.Catalog) i18n.Localizer {
+	var locales []string
+
+	if r, ok := requestFromContext(ctx); ok {
+		locales = i18n.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	}
+
+	return i18n.NewLocalizer(catalog, locales...)
+}
+
+// localizeProblem translates the title, the detail and the validation violations of a problem.
+func localizeProblem(problem interface{}, localizer i18n.Localizer) {
+	if dp, ok := defaultProblemOf(problem); ok {
+		dp.Title, _ = localizer.Localize(dp.Title)
+		dp.Detail, _ = localizer.Localize(dp.Detail)
+	}
+
+	if ep, ok := problem.(*ExtendedProblem); ok {
+		problem = ep.Problem
+	}
+
+	switch p := problem.(type) {
+	case *ValidationProblem:
+		// Violations may be owned by the error, so they are copied instead of being translated in place.
+		violations := make(map[string][]string, len(p.Violations))
+
+		for field, messages := range p.Violations {
+			for _, message := range messages {
+				message, _ = localizer.Localize(message)
+
+				violations[field] = append(violations[field], message)
+			}
+		}
+
+		p.Violations = violations
+
+	case *ValidationErrorsProblem:
+		for i := range p.Errors {
+			p.Errors[i].Detail, _ = localizer.Localize(p.Errors[i].Detail)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moogar0880/problems"
+
+	"github.com/sagikazarmark/appkit/i18n"
+)
+
+func newLocalizedContext(acceptLanguage string) context.Context {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", acceptLanguage)
+
+	return PopulateRequestContext(context.Background(), req)
+}
+
+func TestWithLocalization(t *testing.T) {
+	catalog := i18n.NewMemoryCatalog()
+	catalog.Set("hu", "Not Found", "Nem található")
+	catalog.Set("hu", "not found", "a keresett erőforrás nem található")
+	catalog.Set("hu", "violation", "érvénytelen érték")
+
+	converter := NewDefaultProblemConverter(WithLocalization(catalog))
+
+	t.Run("problem", func(t *testing.T) {
+		problem := converter.NewProblem(newLocalizedContext("de, hu;q=0.8"), notFoundStub{}).(*problems.DefaultProblem)
+
+		if want, have := "Nem található", problem.Title; want != have {
+			t.Errorf("unexpected title\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := "a keresett erőforrás nem található", problem.Detail; want != have {
+			t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		err := validationWithViolationsStub{}
+
+		problem := converter.NewProblem(newLocalizedContext("hu"), err).(*ValidationProblem)
+
+		if want, have := "érvénytelen érték", problem.Violations["field"][0]; want != have {
+			t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		converter := NewDefaultProblemConverter(WithLocalization(catalog), WithValidationErrors())
+
+		problem := converter.NewProblem(newLocalizedContext("hu"), validationWithViolationsStub{}).(*ValidationErrorsProblem)
+
+		if want, have := "érvénytelen érték", problem.Errors[0].Detail; want != have {
+			t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("unsupported_locale", func(t *testing.T) {
+		problem := converter.NewProblem(newLocalizedContext("de"), notFoundStub{}).(*problems.DefaultProblem)
+
+		testProblemEquals(t, problem, http.StatusNotFound, "not found")
+	})
+}
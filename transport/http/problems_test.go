@@ -2,11 +2,18 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/moogar0880/problems"
+	"google.golang.org/grpc/codes"
+
+	"github.com/sagikazarmark/appkit/transport/classification"
 )
 
 type notFoundStub struct{}
@@ -49,6 +56,86 @@ func (conflictStub) Conflict() bool {
 	return true
 }
 
+type unauthenticatedStub struct{}
+
+func (unauthenticatedStub) Error() string {
+	return "unauthenticated"
+}
+
+func (unauthenticatedStub) Unauthenticated() bool {
+	return true
+}
+
+type permissionDeniedStub struct{}
+
+func (permissionDeniedStub) Error() string {
+	return "permission denied"
+}
+
+func (permissionDeniedStub) PermissionDenied() bool {
+	return true
+}
+
+type tooManyRequestsStub struct{}
+
+func (tooManyRequestsStub) Error() string {
+	return "too many requests"
+}
+
+func (tooManyRequestsStub) TooManyRequests() bool {
+	return true
+}
+
+type unavailableStub struct{}
+
+func (unavailableStub) Error() string {
+	return "unavailable"
+}
+
+func (unavailableStub) Unavailable() bool {
+	return true
+}
+
+type timeoutStub struct{}
+
+func (timeoutStub) Error() string {
+	return "timeout"
+}
+
+func (timeoutStub) TimeoutError() bool {
+	return true
+}
+
+type preconditionFailedStub struct{}
+
+func (preconditionFailedStub) Error() string {
+	return "precondition failed"
+}
+
+func (preconditionFailedStub) PreconditionFailed() bool {
+	return true
+}
+
+type alreadyExistsStub struct{}
+
+func (alreadyExistsStub) Error() string {
+	return "already exists"
+}
+
+func (alreadyExistsStub) AlreadyExists() bool {
+	return true
+}
+
+type notImplementedStub struct{}
+
+func (notImplementedStub) Error() string {
+	return "not implemented"
+}
+
+func (notImplementedStub) NotImplemented() bool {
+	return true
+}
+
 func TestDefaultProblemMatchers(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -70,6 +157,38 @@ func TestDefaultProblemMatchers(t *testing.T) {
 			err:            conflictStub{},
 			expectedStatus: http.StatusConflict,
 		},
+		{
+			err:            unauthenticatedStub{},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			err:            permissionDeniedStub{},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			err:            tooManyRequestsStub{},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			err:            unavailableStub{},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			err:            timeoutStub{},
+			expectedStatus: http.StatusGatewayTimeout,
+		},
+		{
+			err:            preconditionFailedStub{},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			err:            alreadyExistsStub{},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			err:            notImplementedStub{},
+			expectedStatus: http.StatusNotImplemented,
+		},
 	}
 
 	converter := NewDefaultProblemConverter()
@@ -128,3 +247,198 @@ func TestDefaultProblemMatchers_ValidationWithViolations(t *testing.T) {
 		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", err.Violations(), problem.Violations)
 	}
 }
+
+type badRequestWithViolationsStub struct{}
+
+func (badRequestWithViolationsStub) Error() string {
+	return "bad request"
+}
+
+func (badRequestWithViolationsStub) BadRequest() bool {
+	return true
+}
+
+func (badRequestWithViolationsStub) Violations() map[string][]string {
+	return map[string][]string{"page_size": {"must be positive"}}
+}
+
+func TestDefaultProblemMatchers_BadRequestWithViolations(t *testing.T) {
+	t.Run("violations", func(t *testing.T) {
+		problem := NewDefaultProblemConverter().NewProblem(context.Background(), badRequestWithViolationsStub{}).(*ValidationProblem)
+
+		if want, have := http.StatusBadRequest, problem.Status; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		if want, have := "must be positive", problem.Violations["page_size"][0]; want != have {
+			t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		converter := NewDefaultProblemConverter(WithValidationErrors())
+
+		problem := converter.NewProblem(context.Background(), badRequestWithViolationsStub{}).(*ValidationErrorsProblem)
+
+		if want, have := http.StatusBadRequest, problem.Status; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		if want, have := "/page_size", problem.Errors[0].Pointer; want != have {
+			t.Errorf("unexpected pointer\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("without_violations", func(t *testing.T) {
+		problem := NewDefaultProblemConverter().NewProblem(context.Background(), badRequestStub{}).(*problems.DefaultProblem)
+
+		if want, have := http.StatusBadRequest, problem.Status; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+	})
+}
+
+type tooManyRequestsWithRetryAfterStub struct {
+	tooManyRequestsStub
+}
+
+func (tooManyRequestsWithRetryAfterStub) RetryAfter() time.Duration {
+	return 1500 * time.Millisecond
+}
+
+func TestDefaultProblemMatchers_TooManyRequestsWithRetryAfter(t *testing.T) {
+	converter := NewDefaultProblemConverter()
+
+	err := tooManyRequestsWithRetryAfterStub{}
+
+	problem := converter.NewProblem(context.Background(), err).(*TooManyRequestsProblem)
+
+	if want, have := http.StatusTooManyRequests, problem.Status; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := err.Error(), problem.Detail; want != have {
+		t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := "2", problem.Headers().Get("Retry-After"); want != have {
+		t.Errorf("unexpected Retry-After header\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewProblemMatchers(t *testing.T) {
+	converter := NewProblemConverter(WithProblemMatchers(NewProblemMatchers([]classification.Class{
+		{Name: "teapot", Matcher: func(err error) bool { return err.Error() == "teapot" }, HTTPStatus: http.StatusTeapot},
+		{Name: "grpc-only", Matcher: func(err error) bool { return true }, GRPCCode: codes.Aborted},
+	})...))
+
+	if want, have := http.StatusTeapot, converter.NewProblem(context.Background(), errors.New("teapot")).(StatusProblem).ProblemStatus(); want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := http.StatusInternalServerError, converter.NewProblem(context.Background(), errors.New("error")).(StatusProblem).ProblemStatus(); want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+}
+
+type violationsStub struct{}
+
+func (violationsStub) Error() string {
+	return "invalid input"
+}
+
+func (violationsStub) Violations() map[string][]string {
+	return map[string][]string{
+		"field": {
+			"violation",
+		},
+	}
+}
+
+type retryAfterStub struct{}
+
+func (retryAfterStub) Error() string {
+	return "overloaded"
+}
+
+func (retryAfterStub) RetryAfter() time.Duration {
+	return time.Minute
+}
+
+func TestNewProblemMatchers_SpecialClasses(t *testing.T) {
+	converter := NewProblemConverter(WithProblemMatchers(NewProblemMatchers([]classification.Class{
+		{
+			Name:       classification.Validation,
+			Matcher:    func(err error) bool { return errors.As(err, &violationsStub{}) },
+			HTTPStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       classification.TooManyRequests,
+			Matcher:    func(err error) bool { return errors.As(err, &retryAfterStub{}) },
+			HTTPStatus: http.StatusServiceUnavailable,
+		},
+	})...))
+
+	t.Run("validation", func(t *testing.T) {
+		problem, ok := converter.NewProblem(context.Background(), violationsStub{}).(*ValidationProblem)
+		if !ok {
+			t.Fatal("problem is expected to be a validation problem")
+		}
+
+		if want, have := http.StatusBadRequest, problem.Status; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		if want, have := http.StatusText(http.StatusBadRequest), problem.Title; want != have {
+			t.Errorf("unexpected title\nexpected: %s\nactual:   %s", want, have)
+		}
+
+		if want, have := "violation", problem.Violations["field"][0]; want != have {
+			t.Errorf("unexpected violation\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("too_many_requests", func(t *testing.T) {
+		problem, ok := converter.NewProblem(context.Background(), retryAfterStub{}).(*TooManyRequestsProblem)
+		if !ok {
+			t.Fatal("problem is expected to carry retry information")
+		}
+
+		if want, have := http.StatusServiceUnavailable, problem.Status; want != have {
+			t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+		}
+
+		if want, have := time.Minute, problem.RetryAfter; want != have {
+			t.Errorf("unexpected retry after\nexpected: %s\nactual:   %s", want, have)
+		}
+	})
+
+	t.Run("default_matchers", func(t *testing.T) {
+		for _, err := range []error{validationWithViolationsStub{}, tooManyRequestsStub{}} {
+			if want, have := http.StatusInternalServerError, converter.NewProblem(context.Background(), err).(StatusProblem).ProblemStatus(); want != have {
+				t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+			}
+		}
+	})
+}
+
+func TestDefaultProblemMatchers_NetTimeout(t *testing.T) {
+	converter := NewDefaultProblemConverter()
+
+	err := fmt.Errorf("query users: %w", &net.OpError{
+		Op:   "dial",
+		Net:  "tcp",
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 5), Port: 5432},
+		Err:  os.ErrDeadlineExceeded,
+	})
+
+	problem := converter.NewProblem(context.Background(), err).(*problems.DefaultProblem)
+
+	if want, have := http.StatusInternalServerError, problem.Status; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := "something went wrong", problem.Detail; want != have {
+		t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+	}
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moogar0880/problems"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+// NewTooManyRequestsProblemMatcher returns a problem matcher for rate limit and quota exhaustion errors.
+// If the returned error matches the following interface, a special problem is returned by NewProblem
+// that carries a Retry-After header:
+//
+//	type retryAfter interface {
+//		RetryAfter() time.Duration
+//	}
+func NewTooManyRequestsProblemMatcher() ProblemMatcher {
+	return newTooManyRequestsProblemMatcher(appkiterrors.IsTooManyRequestsError, http.StatusTooManyRequests)
+}
+
+// newTooManyRequestsProblemMatcher returns a problem matcher adding retry information to problems
+// of errors matched by a custom matcher.
+func newTooManyRequestsProblemMatcher(matcher func(err error) bool, status int) ProblemMatcher {
+	return tooManyRequestsProblemMatcher{
+		matcher: matcher,
+		status:  status,
+	}
+}
+
+type tooManyRequestsProblemMatcher struct {
+	matcher func(err error) bool
+	status  int
+}
+
+func (m tooManyRequestsProblemMatcher) MatchError(err error) bool {
+	return m.matcher(err)
+}
+
+func (m tooManyRequestsProblemMatcher) NewProblem(_ context.Context, err error) interface{} {
+	if retryAfter, ok := appkiterrors.RetryAfter(err); ok {
+		problem := NewTooManyRequestsProblem(err.Error(), retryAfter)
+		setProblemStatus(problem.DefaultProblem, m.status)
+
+		return problem
+	}
+
+	return problems.NewDetailedProblem(m.status, err.Error())
+}
+
+// TooManyRequestsProblem describes an RFC-7807 problem with retry information.
+//
+// TooManyRequestsProblem implements the following interface (compatible with go-kit's Headerer),
+// so that the retry information can be sent to the client in a Retry-After header:
+//
+//	type headerer interface {
+//		Headers() http.Header
+//	}
+type TooManyRequestsProblem struct {
+	*problems.DefaultProblem
+
+	RetryAfter time.Duration `json:"-"`
+}
+
+// NewTooManyRequestsProblem returns a problem with details and retry information.
+func NewTooManyRequestsProblem(details string, retryAfter time.Duration) *TooManyRequestsProblem {
+	return &TooManyRequestsProblem{
+		DefaultProblem: problems.NewDetailedProblem(http.StatusTooManyRequests, details),
+		RetryAfter:     retryAfter,
+	}
+}
+
+// Headers returns the response headers for the problem.
+func (p *TooManyRequestsProblem) Headers() http.Header {
+	header := make(http.Header)
+
+	if p.RetryAfter > 0 {
+		header.Set("Retry-After", strconv.Itoa(int(math.Ceil(p.RetryAfter.Seconds()))))
+	}
+
+	return header
+}
+
+func (p *TooManyRequestsProblem) defaultProblem() *problems.DefaultProblem {
+	return p.DefaultProblem
+}
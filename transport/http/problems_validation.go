@@ -2,8 +2,8 @@ package http
 
 import (
 	"context"
-	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/moogar0880/problems"
 
@@ -16,30 +16,71 @@ import (
 //	type violationError interface {
 //		Violations() map[string][]string
 //	}
+//
+// Violations of joined errors (see errors.Join) are merged into a single problem.
 func NewValidationWithViolationsProblemMatcher() ProblemMatcher {
-	return validationWithViolationsProblemMatcher{}
+	return newValidationWithViolationsProblemMatcher(appkiterrors.IsValidationError, http.StatusUnprocessableEntity)
 }
 
-type violationError interface {
-	Violations() map[string][]string
+// newValidationWithViolationsProblemMatcher returns a problem matcher for errors
+// matched by a custom matcher that contain violations.
+func newValidationWithViolationsProblemMatcher(matcher func(err error) bool, status int) ProblemMatcher {
+	return validationWithViolationsProblemMatcher{
+		matcher: matcher,
+		status:  status,
+	}
 }
 
-type validationWithViolationsProblemMatcher struct{}
+type validationWithViolationsProblemMatcher struct {
+	matcher func(err error) bool
+	status  int
+}
 
 func (v validationWithViolationsProblemMatcher) MatchError(err error) bool {
-	var verr violationError
+	_, ok := appkiterrors.Violations(err)
 
-	return appkiterrors.IsValidationError(err) && errors.As(err, &verr)
+	return v.matcher(err) && ok
 }
 
 func (v validationWithViolationsProblemMatcher) NewProblem(_ context.Context, err error) interface{} {
-	var verr violationError
+	if violations, ok := appkiterrors.Violations(err); ok {
+		problem := NewValidationProblem(err.Error(), violations)
+		setProblemStatus(problem.DefaultProblem, v.status)
+
+		return problem
+	}
+
+	return problems.NewDetailedProblem(v.status, err.Error())
+}
+
+// NewValidationErrorsProblemMatcher returns a problem matcher for validation errors that contain violations.
+// Unlike NewValidationWithViolationsProblemMatcher, it reports violations as an RFC-9457 "errors" array
+// (see ValidationErrorsProblem).
+//
+// Errors carrying an ordered list of field violations (see errors.FieldViolations) are rendered
+// preserving the order, the nested path, the code and the parameters of violations.
+func NewValidationErrorsProblemMatcher() ProblemMatcher {
+	return validationErrorsProblemMatcher{
+		validationWithViolationsProblemMatcher: validationWithViolationsProblemMatcher{
+			matcher: appkiterrors.IsValidationError,
+			status:  http.StatusUnprocessableEntity,
+		},
+	}
+}
 
-	if errors.As(err, &verr) {
-		return NewValidationProblem(err.Error(), verr.Violations())
+type validationErrorsProblemMatcher struct {
+	validationWithViolationsProblemMatcher
+}
+
+func (v validationErrorsProblemMatcher) NewProblem(_ context.Context, err error) interface{} {
+	if violations, ok := appkiterrors.FieldViolations(err); ok {
+		problem := NewFieldValidationErrorsProblem(err.Error(), violations)
+		setProblemStatus(problem.DefaultProblem, v.status)
+
+		return problem
 	}
 
-	return problems.NewDetailedProblem(http.StatusUnprocessableEntity, err.Error())
+	return problems.NewDetailedProblem(v.status, err.Error())
 }
 
 // ValidationProblem describes an RFC-7807 problem with validation violations.
@@ -56,3 +97,77 @@ func NewValidationProblem(details string, violations map[string][]string) *Valid
 		Violations:     violations,
 	}
 }
+
+func (p *ValidationProblem) defaultProblem() *problems.DefaultProblem {
+	return p.DefaultProblem
+}
+
+// ValidationErrorsProblem describes an RFC-9457 problem reporting validation violations as multiple errors.
+//
+// See details at https://www.rfc-editor.org/rfc/rfc9457#name-the-problem-details-json-ob
+type ValidationErrorsProblem struct {
+	*problems.DefaultProblem
+
+	Errors []ValidationErrorDetail `json:"errors"`
+}
+
+// ValidationErrorDetail describes a single validation violation.
+type ValidationErrorDetail struct {
+	// Detail is a human-readable explanation of the violation.
+	Detail string `json:"detail"`
+
+	// Pointer is a JSON Pointer (RFC-6901) to the invalid field in the request.
+	Pointer string `json:"pointer"`
+
+	// Code is a stable, machine-readable code identifying the violated rule (if any).
+	Code string `json:"code,omitempty"`
+
+	// Params contains the parameters of the violated rule (if any).
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// NewValidationErrorsProblem returns a problem with details and validation errors.
+// Violations are ordered by field name.
+func NewValidationErrorsProblem(details string, violations map[string][]string) *ValidationErrorsProblem {
+	return NewFieldValidationErrorsProblem(details, appkiterrors.FieldViolationsFromMap(violations))
+}
+
+// NewFieldValidationErrorsProblem returns a problem with details and validation errors.
+// The order of violations is preserved.
+func NewFieldValidationErrorsProblem(details string, violations []appkiterrors.FieldViolation) *ValidationErrorsProblem {
+	errs := make([]ValidationErrorDetail, 0, len(violations))
+
+	for _, violation := range violations {
+		errs = append(errs, ValidationErrorDetail{
+			Detail:  violation.Message,
+			Pointer: jsonPointer(violation.Path...),
+			Code:    violation.Code,
+			Params:  violation.Params,
+		})
+	}
+
+	return &ValidationErrorsProblem{
+		DefaultProblem: problems.NewDetailedProblem(http.StatusUnprocessableEntity, details),
+		Errors:         errs,
+	}
+}
+
+func (p *ValidationErrorsProblem) defaultProblem() *problems.DefaultProblem {
+	return p.DefaultProblem
+}
+
+// jsonPointerEscaper escapes a JSON Pointer reference token according to RFC-6901.
+// nolint: gochecknoglobals
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// jsonPointer returns a JSON Pointer referencing a field identified by its path.
+func jsonPointer(path ...string) string {
+	var pointer strings.Builder
+
+	for _, segment := range path {
+		pointer.WriteByte('/')
+		pointer.WriteString(jsonPointerEscaper.Replace(segment))
+	}
+
+	return pointer.String()
+}
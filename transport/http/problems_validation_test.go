@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+)
+
+func TestNewValidationErrorsProblem(t *testing.T) {
+	problem := NewValidationErrorsProblem("invalid", map[string][]string{
+		"name":      {"required"},
+		"email":     {"required", "invalid"},
+		"a/b~c":     {"invalid"},
+		"addresses": nil,
+	})
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"invalid","errors":[` +
+		`{"detail":"invalid","pointer":"/a~1b~0c"},` +
+		`{"detail":"required","pointer":"/email"},` +
+		`{"detail":"invalid","pointer":"/email"},` +
+		`{"detail":"required","pointer":"/name"}]}`
+
+	if have := string(body); want != have {
+		t.Errorf("unexpected JSON\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestNewFieldValidationErrorsProblem(t *testing.T) {
+	problem := NewFieldValidationErrorsProblem("invalid", []appkiterrors.FieldViolation{
+		{Path: []string{"name"}, Code: "required", Message: "name is required"},
+		{Path: []string{"addresses", "0", "a/b"}, Code: "min_length", Message: "too short", Params: map[string]interface{}{"min": 3}},
+		{Path: []string{"email"}, Message: "invalid"},
+	})
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"invalid","errors":[` +
+		`{"detail":"name is required","pointer":"/name","code":"required"},` +
+		`{"detail":"too short","pointer":"/addresses/0/a~1b","code":"min_length","params":{"min":3}},` +
+		`{"detail":"invalid","pointer":"/email"}]}`
+
+	if have := string(body); want != have {
+		t.Errorf("unexpected JSON\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestWithValidationErrors(t *testing.T) {
+	converter := NewDefaultProblemConverter(WithValidationErrors())
+
+	err := validationWithViolationsStub{}
+
+	problem := converter.NewProblem(context.Background(), err).(*ValidationErrorsProblem)
+
+	if want, have := http.StatusUnprocessableEntity, problem.Status; want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+
+	if want, have := err.Error(), problem.Detail; want != have {
+		t.Errorf("unexpected detail\nexpected: %s\nactual:   %s", want, have)
+	}
+
+	if want, have := (ValidationErrorDetail{Detail: "violation", Pointer: "/field"}), problem.Errors[0]; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected error\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestNewValidationErrorsProblemMatcher(t *testing.T) {
+	converter := NewProblemConverter(WithProblemMatchers(NewValidationErrorsProblemMatcher()))
+
+	problem, ok := converter.NewProblem(context.Background(), validationWithViolationsStub{}).(*ValidationErrorsProblem)
+	if !ok {
+		t.Fatal("problem is supposed to be a ValidationErrorsProblem")
+	}
+
+	if want, have := "/field", problem.Errors[0].Pointer; want != have {
+		t.Errorf("unexpected pointer\nexpected: %s\nactual:   %s", want, have)
+	}
+}
+
+func TestValidationWithViolationsProblemMatcher_Joined(t *testing.T) {
+	converter := NewDefaultProblemConverter()
+
+	err := errors.Join(
+		appkiterrors.NewValidation("invalid user", map[string][]string{"email": {"required"}}),
+		appkiterrors.NewValidation("invalid address", map[string][]string{"email": {"invalid"}, "city": {"required"}}),
+	)
+
+	problem, ok := converter.NewProblem(context.Background(), err).(*ValidationProblem)
+	if !ok {
+		t.Fatal("problem is supposed to be a ValidationProblem")
+	}
+
+	expected := map[string][]string{
+		"email": {"required", "invalid"},
+		"city":  {"required"},
+	}
+
+	if want, have := expected, problem.Violations; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected violations\nexpected: %v\nactual:   %v", want, have)
+	}
+}
+
+func TestDefaultProblemMatchers_Precedence(t *testing.T) {
+	converter := NewDefaultProblemConverter()
+
+	err := errors.Join(
+		appkiterrors.NewValidation("invalid user", map[string][]string{"email": {"required"}}),
+		appkiterrors.NewNotFound("user", "1"),
+	)
+
+	problem := converter.NewProblem(context.Background(), err).(StatusProblem)
+
+	if want, have := http.StatusNotFound, problem.ProblemStatus(); want != have {
+		t.Errorf("unexpected status\nexpected: %d\nactual:   %d", want, have)
+	}
+}
+
+func TestWithValidationErrors_FieldViolations(t *testing.T) {
+	converter := NewDefaultProblemConverter(WithValidationErrors())
+
+	err := appkiterrors.NewFieldValidation("invalid user", []appkiterrors.FieldViolation{
+		{Path: []string{"name"}, Code: "required", Message: "required"},
+		{Path: []string{"email"}, Code: "invalid", Message: "invalid"},
+	})
+
+	problem := converter.NewProblem(context.Background(), err).(*ValidationErrorsProblem)
+
+	expected := []ValidationErrorDetail{
+		{Detail: "required", Pointer: "/name", Code: "required"},
+		{Detail: "invalid", Pointer: "/email", Code: "invalid"},
+	}
+
+	if want, have := expected, problem.Errors; !reflect.DeepEqual(want, have) {
+		t.Errorf("unexpected errors\nexpected: %v\nactual:   %v", want, have)
+	}
+}